@@ -0,0 +1,86 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DashboardWidgetLayout defines the position of a widget on a free layout dashboard
+type DashboardWidgetLayout struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// DashboardWidget defines a single widget of a Dashboard
+type DashboardWidget struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Definition *runtime.RawExtension  `json:"definition"`
+	Layout     *DashboardWidgetLayout `json:"layout,omitempty"`
+}
+
+// DashboardTemplateVariable defines a template variable of a Dashboard
+type DashboardTemplateVariable struct {
+	Name    string `json:"name"`
+	Prefix  string `json:"prefix,omitempty"`
+	Default string `json:"default,omitempty"`
+}
+
+// DashboardSpec defines the desired state of Dashboard
+type DashboardSpec struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=ordered;free
+	LayoutType        string                      `json:"layoutType"`
+	Widgets           []DashboardWidget           `json:"widgets"`
+	TemplateVariables []DashboardTemplateVariable `json:"templateVariables,omitempty"`
+	NotifyList        []string                    `json:"notifyList,omitempty"`
+	ReadOnly          bool                        `json:"readOnly,omitempty"`
+}
+
+// DashboardStatus defines the observed state of Dashboard
+type DashboardStatus struct {
+	DashboardID string      `json:"dashboardID"`
+	Conditions  []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Dashboard is the Schema for the dashboards API
+type Dashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DashboardSpec   `json:"spec,omitempty"`
+	Status DashboardStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DashboardList contains a list of Dashboard
+type DashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dashboard `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Dashboard{}, &DashboardList{})
+}
@@ -0,0 +1,60 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogAccountSpec defines the desired state of DatadogAccount
+type DatadogAccountSpec struct {
+	// SecretRef names a Secret in the same namespace holding the DD_API_KEY
+	// and DD_APPLICATION_KEY of the DataDog organization.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// Site is the DataDog site of the organization, such as datadoghq.eu,
+	// defaults to the site the operator is configured with.
+	Site string `json:"site,omitempty"`
+}
+
+// DatadogAccountStatus defines the observed state of DatadogAccount
+type DatadogAccountStatus struct {
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// DatadogAccount is the Schema for the datadogaccounts API
+type DatadogAccount struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogAccountSpec   `json:"spec,omitempty"`
+	Status DatadogAccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatadogAccountList contains a list of DatadogAccount
+type DatadogAccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogAccount `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogAccount{}, &DatadogAccountList{})
+}
@@ -0,0 +1,82 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DowntimeRecurrence defines how a Downtime repeats
+type DowntimeRecurrence struct {
+	// +kubebuilder:validation:Enum=days;weeks;months;years
+	Type             string       `json:"type"`
+	Period           int          `json:"period"`
+	WeekDays         []string     `json:"weekDays,omitempty"`
+	UntilDate        *metav1.Time `json:"untilDate,omitempty"`
+	UntilOccurrences int          `json:"untilOccurrences,omitempty"`
+}
+
+// DowntimeSpec defines the desired state of Downtime
+type DowntimeSpec struct {
+	Scope      []string            `json:"scope"`
+	Start      *metav1.Time        `json:"start,omitempty"`
+	End        *metav1.Time        `json:"end,omitempty"`
+	Timezone   string              `json:"timezone,omitempty"`
+	Message    string              `json:"message,omitempty"`
+	Recurrence *DowntimeRecurrence `json:"recurrence,omitempty"`
+
+	// Monitors lists the names of Monitor objects in the same namespace to silence
+	Monitors []string `json:"monitors,omitempty"`
+	// MonitorSelector selects Monitor objects in the same namespace to silence
+	MonitorSelector *metav1.LabelSelector `json:"monitorSelector,omitempty"`
+}
+
+// ScheduledDowntime is a DataDog downtime created for a Downtime
+type ScheduledDowntime struct {
+	MonitorID  int `json:"monitorID,omitempty"`
+	DowntimeID int `json:"downtimeID"`
+}
+
+// DowntimeStatus defines the observed state of Downtime
+type DowntimeStatus struct {
+	Downtimes  []ScheduledDowntime `json:"downtimes,omitempty"`
+	Conditions []Condition         `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Downtime is the Schema for the downtimes API
+type Downtime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DowntimeSpec   `json:"spec,omitempty"`
+	Status DowntimeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DowntimeList contains a list of Downtime
+type DowntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Downtime `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Downtime{}, &DowntimeList{})
+}
@@ -0,0 +1,237 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+// optionsAnnotation keeps the v1beta1 options of a monitor read as v1alpha1
+// when merging them into a single object loses how they were split.
+const optionsAnnotation = "monitoring.datadog.com/v1beta1-options"
+
+type splitOptions struct {
+	Options    *v1beta1.MonitorOptions `json:"options,omitempty"`
+	RawOptions *runtime.RawExtension   `json:"rawOptions,omitempty"`
+}
+
+var _ conversion.Convertible = &Monitor{}
+
+// ConvertTo converts the monitor to the v1beta1 hub version.
+func (src *Monitor) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.Monitor)
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = v1beta1.MonitorSpec{
+		Type:           src.Spec.Type,
+		Query:          src.Spec.Query,
+		Name:           src.Spec.Name,
+		Message:        src.Spec.Message,
+		Tags:           src.Spec.Tags,
+		AccountRef:     src.Spec.AccountRef,
+		DriftPolicy:    v1beta1.DriftPolicy(src.Spec.DriftPolicy),
+		DeletionPolicy: v1beta1.DeletionPolicy(src.Spec.DeletionPolicy),
+		AdoptMonitorID: src.Spec.AdoptMonitorID,
+	}
+
+	if src.Spec.Mute != nil {
+		dst.Spec.Mute = &v1beta1.MonitorMute{Scopes: src.Spec.Mute.Scopes, End: src.Spec.Mute.End}
+	}
+
+	var options []byte
+	if src.Spec.Options != nil {
+		options = src.Spec.Options.Raw
+	}
+
+	saved, ok := dst.Annotations[optionsAnnotation]
+	if ok {
+		delete(dst.Annotations, optionsAnnotation)
+		if len(dst.Annotations) == 0 {
+			dst.Annotations = nil
+		}
+	}
+
+	if !ok || !restoreOptions(&dst.Spec, saved, options) {
+		err := dst.Spec.SetOptions(options)
+		if err != nil {
+			return err
+		}
+	}
+
+	dst.Status = v1beta1.MonitorStatus{
+		MonitorID:          src.Status.MonitorID,
+		ObservedGeneration: src.Status.ObservedGeneration,
+		LastSyncedTime:     src.Status.LastSyncedTime,
+		Site:               src.Status.Site,
+		PlannedAction:      v1beta1.PlannedAction(src.Status.PlannedAction),
+	}
+
+	for _, condition := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, v1beta1.Condition{
+			Type:               v1beta1.ConditionType(condition.Type),
+			Status:             condition.Status,
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	for _, diff := range src.Status.LastAppliedDiff {
+		dst.Status.LastAppliedDiff = append(dst.Status.LastAppliedDiff, v1beta1.FieldDiff(diff))
+	}
+
+	for _, muted := range src.Status.MutedScopes {
+		dst.Status.MutedScopes = append(dst.Status.MutedScopes, v1beta1.MutedScope(muted))
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the monitor from the v1beta1 hub version.
+func (dst *Monitor) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.Monitor)
+
+	dst.ObjectMeta = *src.ObjectMeta.DeepCopy()
+
+	dst.Spec = MonitorSpec{
+		Type:           src.Spec.Type,
+		Query:          src.Spec.Query,
+		Name:           src.Spec.Name,
+		Message:        src.Spec.Message,
+		Tags:           src.Spec.Tags,
+		AccountRef:     src.Spec.AccountRef,
+		DriftPolicy:    DriftPolicy(src.Spec.DriftPolicy),
+		DeletionPolicy: DeletionPolicy(src.Spec.DeletionPolicy),
+		AdoptMonitorID: src.Spec.AdoptMonitorID,
+	}
+
+	if src.Spec.Mute != nil {
+		dst.Spec.Mute = &MonitorMute{Scopes: src.Spec.Mute.Scopes, End: src.Spec.Mute.End}
+	}
+
+	options, err := src.Spec.MergedOptions()
+	if err != nil {
+		return err
+	}
+
+	if options != nil {
+		dst.Spec.Options = &runtime.RawExtension{Raw: options}
+	}
+
+	split := v1beta1.MonitorSpec{}
+	err = split.SetOptions(options)
+	if err != nil {
+		return err
+	}
+
+	if !reflect.DeepEqual(split.Options, src.Spec.Options) || !rawEqual(split.RawOptions, src.Spec.RawOptions) {
+		saved, err := json.Marshal(splitOptions{Options: src.Spec.Options, RawOptions: src.Spec.RawOptions})
+		if err != nil {
+			return err
+		}
+
+		if dst.Annotations == nil {
+			dst.Annotations = map[string]string{}
+		}
+		dst.Annotations[optionsAnnotation] = string(saved)
+	}
+
+	dst.Status = MonitorStatus{
+		MonitorID:          src.Status.MonitorID,
+		ObservedGeneration: src.Status.ObservedGeneration,
+		LastSyncedTime:     src.Status.LastSyncedTime,
+		Site:               src.Status.Site,
+		PlannedAction:      PlannedAction(src.Status.PlannedAction),
+	}
+
+	for _, condition := range src.Status.Conditions {
+		dst.Status.Conditions = append(dst.Status.Conditions, Condition{
+			Type:               ConditionType(condition.Type),
+			Status:             condition.Status,
+			LastTransitionTime: condition.LastTransitionTime,
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+
+	for _, diff := range src.Status.LastAppliedDiff {
+		dst.Status.LastAppliedDiff = append(dst.Status.LastAppliedDiff, FieldDiff(diff))
+	}
+
+	for _, muted := range src.Status.MutedScopes {
+		dst.Status.MutedScopes = append(dst.Status.MutedScopes, MutedScope(muted))
+	}
+
+	return nil
+}
+
+// restoreOptions sets the options saved in the annotation when they still
+// match the v1alpha1 options, which changes when the object was edited as
+// v1alpha1 after the annotation was written.
+func restoreOptions(spec *v1beta1.MonitorSpec, saved string, options []byte) bool {
+	var split splitOptions
+	err := json.Unmarshal([]byte(saved), &split)
+	if err != nil {
+		return false
+	}
+
+	restored := v1beta1.MonitorSpec{Options: split.Options, RawOptions: split.RawOptions}
+	merged, err := restored.MergedOptions()
+	if err != nil || !jsonEqual(merged, options) {
+		return false
+	}
+
+	spec.Options = split.Options
+	spec.RawOptions = split.RawOptions
+
+	return true
+}
+
+func rawEqual(a, b *runtime.RawExtension) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return jsonEqual(a.Raw, b.Raw)
+}
+
+func jsonEqual(a, b []byte) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	var av, bv interface{}
+	if decodeNumbers(a, &av) != nil || decodeNumbers(b, &bv) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(av, bv)
+}
+
+func decodeNumbers(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
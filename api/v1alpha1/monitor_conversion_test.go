@@ -0,0 +1,128 @@
+package v1alpha1_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"gotest.tools/assert"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+// fuzzOptions builds monitor options mixing values v1beta1 has typed fields
+// for with values it can only keep as raw options.
+func fuzzOptions(c fuzz.Continue) []byte {
+	options := map[string]interface{}{}
+
+	if c.RandBool() {
+		typed := &monitoringv1beta1.MonitorOptions{}
+		c.Fuzz(typed)
+
+		data, _ := json.Marshal(typed)
+		_ = json.Unmarshal(data, &options)
+	}
+	if c.RandBool() {
+		options["notification_preset_name"] = c.RandString()
+	}
+	if c.RandBool() {
+		options["timeout_h"] = json.Number("2.0")
+	}
+	if c.RandBool() {
+		options["thresholds"] = map[string]interface{}{"critical": c.RandString()}
+	}
+	if c.RandBool() {
+		options["locked"] = nil
+	}
+
+	data, _ := json.Marshal(options)
+
+	return data
+}
+
+func newFuzzer() *fuzz.Fuzzer {
+	return fuzz.New().NilChance(0.3).NumElements(0, 3).Funcs(
+		// the conversion webhook sets the type of converted objects
+		func(*metav1.TypeMeta, fuzz.Continue) {},
+		func(raw *runtime.RawExtension, c fuzz.Continue) {
+			raw.Raw = fuzzOptions(c)
+		},
+	)
+}
+
+// normalize rewrites raw options so equal JSON compares equal.
+func normalize(t *testing.T, raw *runtime.RawExtension) {
+	if raw == nil {
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw.Raw))
+	decoder.UseNumber()
+
+	var value interface{}
+	assert.NilError(t, decoder.Decode(&value))
+
+	data, err := json.Marshal(value)
+	assert.NilError(t, err)
+
+	raw.Raw = data
+}
+
+func TestMonitorConversionFromV1alpha1(t *testing.T) {
+	fuzzer := newFuzzer()
+
+	for i := 0; i < 1000; i++ {
+		monitor := &monitoringv1alpha1.Monitor{}
+		fuzzer.Fuzz(monitor)
+
+		hub := &monitoringv1beta1.Monitor{}
+		assert.NilError(t, monitor.ConvertTo(hub))
+
+		converted := &monitoringv1alpha1.Monitor{}
+		assert.NilError(t, converted.ConvertFrom(hub))
+
+		normalize(t, monitor.Spec.Options)
+		normalize(t, converted.Spec.Options)
+		assert.Assert(t, apiequality.Semantic.DeepEqual(monitor, converted), "%#v != %#v", monitor, converted)
+	}
+}
+
+func TestMonitorConversionFromV1beta1(t *testing.T) {
+	fuzzer := newFuzzer()
+
+	for i := 0; i < 1000; i++ {
+		hub := &monitoringv1beta1.Monitor{}
+		fuzzer.Fuzz(hub)
+
+		monitor := &monitoringv1alpha1.Monitor{}
+		assert.NilError(t, monitor.ConvertFrom(hub))
+
+		converted := &monitoringv1beta1.Monitor{}
+		assert.NilError(t, monitor.ConvertTo(converted))
+
+		normalize(t, hub.Spec.RawOptions)
+		normalize(t, converted.Spec.RawOptions)
+		assert.Assert(t, apiequality.Semantic.DeepEqual(hub, converted), "%#v != %#v", hub, converted)
+	}
+}
+
+func TestMonitorConversionSplitsOptions(t *testing.T) {
+	monitor := &monitoringv1alpha1.Monitor{
+		Spec: monitoringv1alpha1.MonitorSpec{
+			Options: &runtime.RawExtension{Raw: []byte(`{"notify_no_data": true, "notification_preset_name": "hide_query"}`)},
+		},
+	}
+
+	hub := &monitoringv1beta1.Monitor{}
+	assert.NilError(t, monitor.ConvertTo(hub))
+
+	notify := true
+	assert.DeepEqual(t, hub.Spec.Options, &monitoringv1beta1.MonitorOptions{NotifyNoData: &notify})
+	assert.Equal(t, string(hub.Spec.RawOptions.Raw), `{"notification_preset_name":"hide_query"}`)
+	assert.Assert(t, hub.Annotations == nil)
+}
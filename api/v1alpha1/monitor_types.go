@@ -16,6 +16,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -23,23 +24,125 @@ import (
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// DriftPolicy decides what happens when a monitor is changed in DataDog
+// outside of its spec.
+// +kubebuilder:validation:Enum=Revert;Report
+type DriftPolicy string
+
+const (
+	// DriftPolicyRevert overwrites the changes made in DataDog with the spec
+	DriftPolicyRevert DriftPolicy = "Revert"
+	// DriftPolicyReport keeps the changes made in DataDog and sets the Drifted condition
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// DeletionPolicy decides what happens to a monitor in DataDog when its
+// Kubernetes object is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the monitor from DataDog
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the monitor in DataDog
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// PlannedAction is a change to DataDog skipped in dry run mode.
+type PlannedAction string
+
+const (
+	PlannedActionNone   PlannedAction = "None"
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionAdopt  PlannedAction = "Adopt"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+	PlannedActionOrphan PlannedAction = "Orphan"
+)
+
 // MonitorSpec defines the desired state of Monitor
 type MonitorSpec struct {
-	Type    string                `json:"type"`
-	Query   string                `json:"query"`
-	Name    string                `json:"name"`
-	Message string                `json:"message"`
-	Tags    []string              `json:"tags"`
+	Type    string   `json:"type"`
+	Query   string   `json:"query"`
+	Name    string   `json:"name"`
+	Message string   `json:"message"`
+	Tags    []string `json:"tags"`
+	// +kubebuilder:pruning:PreserveUnknownFields
 	Options *runtime.RawExtension `json:"options"`
+
+	// AccountRef names a DatadogAccount in the same namespace to manage the
+	// monitor with, the operator credentials are used when not set.
+	AccountRef *corev1.LocalObjectReference `json:"accountRef,omitempty"`
+
+	// DriftPolicy decides what happens when the monitor is changed in
+	// DataDog outside of its spec, defaults to Revert.
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// DeletionPolicy decides whether the DataDog monitor is deleted along
+	// with the object, defaults to the operator --default-deletion-policy.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptMonitorID takes over an existing DataDog monitor instead of
+	// creating a new one.
+	AdoptMonitorID int `json:"adoptMonitorID,omitempty"`
+
+	// Mute mutes the notifications of the monitor, applied to DataDog
+	// separately from the rest of the spec.
+	Mute *MonitorMute `json:"mute,omitempty"`
+}
+
+// MonitorMute mutes a monitor, entirely or for some scopes
+type MonitorMute struct {
+	// Scopes to mute, such as host:web-1, the whole monitor is muted when empty
+	Scopes []string `json:"scopes,omitempty"`
+	// End is when the mute ends, without it the monitor stays muted until
+	// the mute is removed from the spec
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// MutedScope is a scope of a monitor muted in DataDog
+type MutedScope struct {
+	// Scope is the muted scope, * when the whole monitor is muted
+	Scope string `json:"scope"`
+	// End is when the mute ends, not set when it lasts until unmuted
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// FieldDiff is a field changed by an update, with its old and new values as JSON
+type FieldDiff struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
 }
 
 // MonitorStatus defines the observed state of Monitor
 type MonitorStatus struct {
-	MonitorID int `json:"monitorID"`
+	MonitorID          int         `json:"monitorID"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	// LastSyncedTime is the time of the last successful sync with DataDog.
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+	// Site is the DataDog site the monitor was created in.
+	Site string `json:"site,omitempty"`
+	// PlannedAction is the change the operator would make in DataDog when
+	// running with --dry-run.
+	PlannedAction PlannedAction `json:"plannedAction,omitempty"`
+	// LastAppliedDiff lists the fields changed by the last update to the
+	// DataDog monitor.
+	LastAppliedDiff []FieldDiff `json:"lastAppliedDiff,omitempty"`
+	// MutedScopes is the mute state of the monitor in DataDog.
+	MutedScopes []MutedScope `json:"mutedScopes,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.monitorID"
+// +kubebuilder:printcolumn:name="Site",type="string",JSONPath=".status.site",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+// +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.conditions[?(@.type==\"Error\")].message"
+// +kubebuilder:printcolumn:name="Last Synced",type="date",JSONPath=".status.lastSyncedTime",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Monitor is the Schema for the monitors API
 type Monitor struct {
@@ -0,0 +1,140 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+type monitorOptions struct {
+	Thresholds *v1beta1.MonitorThresholds `json:"thresholds"`
+	Silenced   map[string]int             `json:"silenced"`
+}
+
+func (r *Monitor) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-monitoring-datadog-com-v1alpha1-monitor,mutating=false,failurePolicy=fail,groups=monitoring.datadog.com,resources=monitors,versions=v1alpha1,name=vmonitor.monitoring.datadog.com
+
+var _ webhook.Validator = &Monitor{}
+
+func (r *Monitor) ValidateCreate() error {
+	return r.validate()
+}
+
+// ValidateUpdate only validates changes to the spec, so monitors admitted
+// before a validation was added can still be finalized and deleted.
+func (r *Monitor) ValidateUpdate(old runtime.Object) error {
+	if r.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if oldMonitor, ok := old.(*Monitor); ok && apiequality.Semantic.DeepEqual(oldMonitor.Spec, r.Spec) {
+		return nil
+	}
+
+	return r.validate()
+}
+
+func (r *Monitor) ValidateDelete() error {
+	return nil
+}
+
+func (r *Monitor) validate() error {
+	errs := r.Spec.validate(field.NewPath("spec"))
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupVersion.Group, Kind: "Monitor"},
+		r.Name, errs)
+}
+
+func (s *MonitorSpec) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !v1beta1.IsMonitorType(s.Type) {
+		errs = append(errs, field.NotSupported(path.Child("type"), s.Type, v1beta1.MonitorTypes))
+	}
+
+	if strings.TrimSpace(s.Query) == "" {
+		errs = append(errs, field.Required(path.Child("query"), "query must not be empty"))
+	}
+
+	seen := map[string]bool{}
+	for i, tag := range s.Tags {
+		if seen[tag] {
+			errs = append(errs, field.Duplicate(path.Child("tags").Index(i), tag))
+		}
+		seen[tag] = true
+	}
+
+	errs = append(errs, s.validateOptions(path)...)
+
+	if s.Mute != nil {
+		errs = append(errs, v1beta1.ValidateMuteScopes(path.Child("mute", "scopes"), s.Mute.Scopes)...)
+	}
+
+	return errs
+}
+
+func (s *MonitorSpec) validateOptions(specPath *field.Path) field.ErrorList {
+	path := specPath.Child("options")
+
+	if s.Options == nil || len(s.Options.Raw) == 0 {
+		return field.ErrorList{field.Required(path, "options must be set, use {} for none")}
+	}
+
+	var raw map[string]interface{}
+	err := json.Unmarshal(s.Options.Raw, &raw)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, string(s.Options.Raw), fmt.Sprintf("must be a JSON object: %v", err))}
+	}
+
+	var options monitorOptions
+	err = json.Unmarshal(s.Options.Raw, &options)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, string(s.Options.Raw), err.Error())}
+	}
+
+	var errs field.ErrorList
+
+	if s.Mute != nil && options.Silenced != nil {
+		errs = append(errs, field.Forbidden(specPath.Child("mute"), "must not be set together with options.silenced"))
+	}
+
+	if options.Thresholds != nil {
+		errs = append(errs, v1beta1.ValidateThresholds(path.Child("thresholds"), s.Query, options.Thresholds)...)
+	}
+
+	return errs
+}
@@ -0,0 +1,100 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+)
+
+func monitorWith(change func(spec *monitoringv1alpha1.MonitorSpec)) *monitoringv1alpha1.Monitor {
+	monitor := &monitoringv1alpha1.Monitor{
+		Spec: monitoringv1alpha1.MonitorSpec{
+			Type:    "metric alert",
+			Name:    "High CPU",
+			Message: "CPU is high",
+			Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+			Tags:    []string{"team:a"},
+			Options: &runtime.RawExtension{Raw: []byte(`{"thresholds": {"critical": 90, "warning": 80}}`)},
+		},
+	}
+
+	change(&monitor.Spec)
+
+	return monitor
+}
+
+func options(raw string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(raw)}
+}
+
+func TestValidateMonitor(t *testing.T) {
+	tests := []struct {
+		monitor *monitoringv1alpha1.Monitor
+		err     string
+	}{
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {}), ""},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Type = "metric" }), `spec.type: Unsupported value: "metric"`},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Query = " " }), "spec.query: Required value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Tags = []string{"a", "b", "a"} }), `spec.tags[2]: Duplicate value: "a"`},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Options = nil }), "spec.options: Required value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Options = options(`[]`) }), "spec.options: Invalid value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Options = options(`{"thresholds": {"critical": "90"}}`)
+		}), "spec.options: Invalid value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Options = options(`{"thresholds": {"critical": 95}}`) }), "spec.options.thresholds.critical: Invalid value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Options = options(`{"thresholds": {"critical": 90, "warning": 95}}`)
+		}), "spec.options.thresholds.warning: Invalid value: 95: must be below critical"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Options = options(`{"thresholds": {"critical": 90, "critical_recovery": 90}}`)
+		}), "spec.options.thresholds.critical_recovery: Invalid value"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Query = "avg(last_5m):avg:system.disk.free{*} < 10"
+			spec.Options = options(`{"thresholds": {"critical": 10, "warning": 20, "warning_recovery": 15}}`)
+		}), "spec.options.thresholds.warning_recovery: Invalid value: 15: must be above warning"},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Type = "service check"
+			spec.Query = `"http.can_connect".over("*").by("url").last(2).count_by_status()`
+			spec.Options = options(`{"thresholds": {"critical": 1, "ok": 1}}`)
+		}), ""},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Mute = &monitoringv1alpha1.MonitorMute{Scopes: []string{"host:web-1", "host:web-1"}}
+		}), `spec.mute.scopes[1]: Duplicate value: "host:web-1"`},
+		{monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) {
+			spec.Mute = &monitoringv1alpha1.MonitorMute{}
+			spec.Options = options(`{"silenced": {"*": 0}}`)
+		}), "spec.mute: Forbidden: must not be set together with options.silenced"},
+	}
+
+	for _, test := range tests {
+		err := test.monitor.ValidateCreate()
+
+		if test.err == "" {
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, test.err)
+		}
+	}
+}
+
+func TestValidateMonitorUpdate(t *testing.T) {
+	invalid := monitorWith(func(spec *monitoringv1alpha1.MonitorSpec) { spec.Type = "metric" })
+
+	unchanged := invalid.DeepCopy()
+	unchanged.Finalizers = []string{"monitoring.datadog.com.monitor"}
+	assert.NilError(t, unchanged.ValidateUpdate(invalid))
+
+	now := metav1.Now()
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &now
+	deleting.Spec.Name = "Changed"
+	assert.NilError(t, deleting.ValidateUpdate(invalid))
+
+	changed := invalid.DeepCopy()
+	changed.Spec.Name = "Changed"
+	assert.ErrorContains(t, changed.ValidateUpdate(invalid), `spec.type: Unsupported value: "metric"`)
+}
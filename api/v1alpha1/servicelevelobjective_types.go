@@ -0,0 +1,86 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceLevelObjectiveThreshold defines the target of a ServiceLevelObjective over a timeframe
+type ServiceLevelObjectiveThreshold struct {
+	// +kubebuilder:validation:Enum=7d;30d;90d
+	Timeframe string `json:"timeframe"`
+	// +kubebuilder:validation:Pattern=^\d+(\.\d+)?$
+	Target string `json:"target"`
+	// +kubebuilder:validation:Pattern=^\d+(\.\d+)?$
+	Warning string `json:"warning,omitempty"`
+}
+
+// ServiceLevelObjectiveQuery defines the good and total events of a metric based ServiceLevelObjective
+type ServiceLevelObjectiveQuery struct {
+	Numerator   string `json:"numerator"`
+	Denominator string `json:"denominator"`
+}
+
+// ServiceLevelObjectiveSpec defines the desired state of ServiceLevelObjective
+type ServiceLevelObjectiveSpec struct {
+	// +kubebuilder:validation:Enum=metric;monitor
+	Type        string                           `json:"type"`
+	Name        string                           `json:"name"`
+	Description string                           `json:"description,omitempty"`
+	Tags        []string                         `json:"tags,omitempty"`
+	Thresholds  []ServiceLevelObjectiveThreshold `json:"thresholds"`
+
+	// Query is required for metric based objectives
+	Query *ServiceLevelObjectiveQuery `json:"query,omitempty"`
+
+	// Monitors lists the names of Monitor objects in the same namespace backing
+	// a monitor based objective
+	Monitors []string `json:"monitors,omitempty"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// ServiceLevelObjectiveStatus defines the observed state of ServiceLevelObjective
+type ServiceLevelObjectiveStatus struct {
+	SLOID      string      `json:"sloID"`
+	MonitorIDs []int       `json:"monitorIDs,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ServiceLevelObjective is the Schema for the servicelevelobjectives API
+type ServiceLevelObjective struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLevelObjectiveSpec   `json:"spec,omitempty"`
+	Status ServiceLevelObjectiveStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceLevelObjectiveList contains a list of ServiceLevelObjective
+type ServiceLevelObjectiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLevelObjective `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceLevelObjective{}, &ServiceLevelObjectiveList{})
+}
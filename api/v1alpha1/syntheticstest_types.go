@@ -0,0 +1,99 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// SyntheticsTestRequest defines the request made by a SyntheticsTest, HTTP
+// tests use the URL while TCP and DNS tests use the host
+type SyntheticsTestRequest struct {
+	URL string `json:"url,omitempty"`
+	// +kubebuilder:validation:Enum=GET;POST;PATCH;PUT;DELETE;HEAD;OPTIONS
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+	Timeout int               `json:"timeout,omitempty"`
+	Host    string            `json:"host,omitempty"`
+	Port    int               `json:"port,omitempty"`
+}
+
+// SyntheticsTestAssertion defines a check made against the response of a SyntheticsTest
+type SyntheticsTestAssertion struct {
+	Type     string             `json:"type"`
+	Operator string             `json:"operator"`
+	Property string             `json:"property,omitempty"`
+	Target   intstr.IntOrString `json:"target"`
+}
+
+// SyntheticsTestOptions defines how a SyntheticsTest is run
+type SyntheticsTestOptions struct {
+	// TickEvery is the run frequency in seconds
+	// +kubebuilder:validation:Enum=60;300;900;1800;3600;21600;43200;86400;604800
+	TickEvery          int   `json:"tickEvery"`
+	FollowRedirects    *bool `json:"followRedirects,omitempty"`
+	MinFailureDuration *int  `json:"minFailureDuration,omitempty"`
+	MinLocationFailed  *int  `json:"minLocationFailed,omitempty"`
+	AcceptSelfSigned   *bool `json:"acceptSelfSigned,omitempty"`
+}
+
+// SyntheticsTestSpec defines the desired state of SyntheticsTest
+type SyntheticsTestSpec struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=http;tcp;dns
+	Subtype    string                    `json:"subtype"`
+	Message    string                    `json:"message,omitempty"`
+	Tags       []string                  `json:"tags,omitempty"`
+	Locations  []string                  `json:"locations"`
+	Request    SyntheticsTestRequest     `json:"request"`
+	Assertions []SyntheticsTestAssertion `json:"assertions"`
+	Options    SyntheticsTestOptions     `json:"options"`
+	Paused     bool                      `json:"paused,omitempty"`
+}
+
+// SyntheticsTestStatus defines the observed state of SyntheticsTest
+type SyntheticsTestStatus struct {
+	PublicID   string      `json:"publicID"`
+	MonitorID  int         `json:"monitorID,omitempty"`
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SyntheticsTest is the Schema for the syntheticstests API
+type SyntheticsTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SyntheticsTestSpec   `json:"spec,omitempty"`
+	Status SyntheticsTestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SyntheticsTestList contains a list of SyntheticsTest
+type SyntheticsTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SyntheticsTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SyntheticsTest{}, &SyntheticsTestList{})
+}
@@ -20,30 +20,664 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dashboard) DeepCopyInto(out *Dashboard) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dashboard.
+func (in *Dashboard) DeepCopy() *Dashboard {
+	if in == nil {
+		return nil
+	}
+	out := new(Dashboard)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dashboard) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardList) DeepCopyInto(out *DashboardList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dashboard, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardList.
+func (in *DashboardList) DeepCopy() *DashboardList {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DashboardList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardSpec) DeepCopyInto(out *DashboardSpec) {
+	*out = *in
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]DashboardWidget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TemplateVariables != nil {
+		in, out := &in.TemplateVariables, &out.TemplateVariables
+		*out = make([]DashboardTemplateVariable, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotifyList != nil {
+		in, out := &in.NotifyList, &out.NotifyList
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardSpec.
+func (in *DashboardSpec) DeepCopy() *DashboardSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardStatus) DeepCopyInto(out *DashboardStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardStatus.
+func (in *DashboardStatus) DeepCopy() *DashboardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardTemplateVariable) DeepCopyInto(out *DashboardTemplateVariable) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardTemplateVariable.
+func (in *DashboardTemplateVariable) DeepCopy() *DashboardTemplateVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardTemplateVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidget) DeepCopyInto(out *DashboardWidget) {
+	*out = *in
+	if in.Definition != nil {
+		in, out := &in.Definition, &out.Definition
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(DashboardWidgetLayout)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidget.
+func (in *DashboardWidget) DeepCopy() *DashboardWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardWidgetLayout) DeepCopyInto(out *DashboardWidgetLayout) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardWidgetLayout.
+func (in *DashboardWidgetLayout) DeepCopy() *DashboardWidgetLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardWidgetLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAccount) DeepCopyInto(out *DatadogAccount) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAccount.
+func (in *DatadogAccount) DeepCopy() *DatadogAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogAccount) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAccountList) DeepCopyInto(out *DatadogAccountList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogAccount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAccountList.
+func (in *DatadogAccountList) DeepCopy() *DatadogAccountList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAccountList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogAccountList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAccountSpec) DeepCopyInto(out *DatadogAccountSpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAccountSpec.
+func (in *DatadogAccountSpec) DeepCopy() *DatadogAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAccountStatus) DeepCopyInto(out *DatadogAccountStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAccountStatus.
+func (in *DatadogAccountStatus) DeepCopy() *DatadogAccountStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAccountStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Downtime) DeepCopyInto(out *Downtime) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Downtime.
+func (in *Downtime) DeepCopy() *Downtime {
+	if in == nil {
+		return nil
+	}
+	out := new(Downtime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Downtime) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DowntimeList) DeepCopyInto(out *DowntimeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Downtime, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeList.
+func (in *DowntimeList) DeepCopy() *DowntimeList {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DowntimeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DowntimeRecurrence) DeepCopyInto(out *DowntimeRecurrence) {
+	*out = *in
+	if in.WeekDays != nil {
+		in, out := &in.WeekDays, &out.WeekDays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UntilDate != nil {
+		in, out := &in.UntilDate, &out.UntilDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeRecurrence.
+func (in *DowntimeRecurrence) DeepCopy() *DowntimeRecurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeRecurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DowntimeSpec) DeepCopyInto(out *DowntimeSpec) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(DowntimeRecurrence)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorSelector != nil {
+		in, out := &in.MonitorSelector, &out.MonitorSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeSpec.
+func (in *DowntimeSpec) DeepCopy() *DowntimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DowntimeStatus) DeepCopyInto(out *DowntimeStatus) {
+	*out = *in
+	if in.Downtimes != nil {
+		in, out := &in.Downtimes, &out.Downtimes
+		*out = make([]ScheduledDowntime, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeStatus.
+func (in *DowntimeStatus) DeepCopy() *DowntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DowntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FieldDiff) DeepCopyInto(out *FieldDiff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FieldDiff.
+func (in *FieldDiff) DeepCopy() *FieldDiff {
+	if in == nil {
+		return nil
+	}
+	out := new(FieldDiff)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Monitor) DeepCopyInto(out *Monitor) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
+func (in *Monitor) DeepCopy() *Monitor {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Monitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorList) DeepCopyInto(out *MonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Monitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
+func (in *MonitorList) DeepCopy() *MonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorMute) DeepCopyInto(out *MonitorMute) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorMute.
+func (in *MonitorMute) DeepCopy() *MonitorMute {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorMute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountRef != nil {
+		in, out := &in.AccountRef, &out.AccountRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Mute != nil {
+		in, out := &in.Mute, &out.Mute
+		*out = new(MonitorMute)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
+func (in *MonitorSpec) DeepCopy() *MonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSyncedTime != nil {
+		in, out := &in.LastSyncedTime, &out.LastSyncedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAppliedDiff != nil {
+		in, out := &in.LastAppliedDiff, &out.LastAppliedDiff
+		*out = make([]FieldDiff, len(*in))
+		copy(*out, *in)
+	}
+	if in.MutedScopes != nil {
+		in, out := &in.MutedScopes, &out.MutedScopes
+		*out = make([]MutedScope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
+func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutedScope) DeepCopyInto(out *MutedScope) {
+	*out = *in
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutedScope.
+func (in *MutedScope) DeepCopy() *MutedScope {
+	if in == nil {
+		return nil
+	}
+	out := new(MutedScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduledDowntime) DeepCopyInto(out *ScheduledDowntime) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduledDowntime.
+func (in *ScheduledDowntime) DeepCopy() *ScheduledDowntime {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduledDowntime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitor.
-func (in *Monitor) DeepCopy() *Monitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitor)
+	out := new(ServiceLevelObjective)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Monitor) DeepCopyObject() runtime.Object {
+func (in *ServiceLevelObjective) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -51,31 +685,31 @@ func (in *Monitor) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorList) DeepCopyInto(out *MonitorList) {
+func (in *ServiceLevelObjectiveList) DeepCopyInto(out *ServiceLevelObjectiveList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Monitor, len(*in))
+		*out = make([]ServiceLevelObjective, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorList.
-func (in *MonitorList) DeepCopy() *MonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveList.
+func (in *ServiceLevelObjectiveList) DeepCopy() *ServiceLevelObjectiveList {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorList)
+	out := new(ServiceLevelObjectiveList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MonitorList) DeepCopyObject() runtime.Object {
+func (in *ServiceLevelObjectiveList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -83,41 +717,284 @@ func (in *MonitorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorSpec) DeepCopyInto(out *MonitorSpec) {
+func (in *ServiceLevelObjectiveQuery) DeepCopyInto(out *ServiceLevelObjectiveQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveQuery.
+func (in *ServiceLevelObjectiveQuery) DeepCopy() *ServiceLevelObjectiveQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveSpec) DeepCopyInto(out *ServiceLevelObjectiveSpec) {
 	*out = *in
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]ServiceLevelObjectiveThreshold, len(*in))
+		copy(*out, *in)
+	}
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(ServiceLevelObjectiveQuery)
+		**out = **in
+	}
+	if in.Monitors != nil {
+		in, out := &in.Monitors, &out.Monitors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorSpec.
-func (in *MonitorSpec) DeepCopy() *MonitorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveSpec.
+func (in *ServiceLevelObjectiveSpec) DeepCopy() *ServiceLevelObjectiveSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorSpec)
+	out := new(ServiceLevelObjectiveSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MonitorStatus) DeepCopyInto(out *MonitorStatus) {
+func (in *ServiceLevelObjectiveStatus) DeepCopyInto(out *ServiceLevelObjectiveStatus) {
 	*out = *in
+	if in.MonitorIDs != nil {
+		in, out := &in.MonitorIDs, &out.MonitorIDs
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MonitorStatus.
-func (in *MonitorStatus) DeepCopy() *MonitorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveStatus.
+func (in *ServiceLevelObjectiveStatus) DeepCopy() *ServiceLevelObjectiveStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MonitorStatus)
+	out := new(ServiceLevelObjectiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveThreshold) DeepCopyInto(out *ServiceLevelObjectiveThreshold) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveThreshold.
+func (in *ServiceLevelObjectiveThreshold) DeepCopy() *ServiceLevelObjectiveThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTest) DeepCopyInto(out *SyntheticsTest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTest.
+func (in *SyntheticsTest) DeepCopy() *SyntheticsTest {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyntheticsTest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestAssertion) DeepCopyInto(out *SyntheticsTestAssertion) {
+	*out = *in
+	out.Target = in.Target
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestAssertion.
+func (in *SyntheticsTestAssertion) DeepCopy() *SyntheticsTestAssertion {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestAssertion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestList) DeepCopyInto(out *SyntheticsTestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SyntheticsTest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestList.
+func (in *SyntheticsTestList) DeepCopy() *SyntheticsTestList {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SyntheticsTestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestOptions) DeepCopyInto(out *SyntheticsTestOptions) {
+	*out = *in
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinFailureDuration != nil {
+		in, out := &in.MinFailureDuration, &out.MinFailureDuration
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinLocationFailed != nil {
+		in, out := &in.MinLocationFailed, &out.MinLocationFailed
+		*out = new(int)
+		**out = **in
+	}
+	if in.AcceptSelfSigned != nil {
+		in, out := &in.AcceptSelfSigned, &out.AcceptSelfSigned
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestOptions.
+func (in *SyntheticsTestOptions) DeepCopy() *SyntheticsTestOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestRequest) DeepCopyInto(out *SyntheticsTestRequest) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestRequest.
+func (in *SyntheticsTestRequest) DeepCopy() *SyntheticsTestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestSpec) DeepCopyInto(out *SyntheticsTestSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Request.DeepCopyInto(&out.Request)
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]SyntheticsTestAssertion, len(*in))
+		copy(*out, *in)
+	}
+	in.Options.DeepCopyInto(&out.Options)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestSpec.
+func (in *SyntheticsTestSpec) DeepCopy() *SyntheticsTestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyntheticsTestStatus) DeepCopyInto(out *SyntheticsTestStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyntheticsTestStatus.
+func (in *SyntheticsTestStatus) DeepCopy() *SyntheticsTestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SyntheticsTestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
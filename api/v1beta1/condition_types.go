@@ -0,0 +1,48 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the type of a status condition
+type ConditionType string
+
+const (
+	// ConditionReady is true when the object exists in DataDog and matches its spec
+	ConditionReady ConditionType = "Ready"
+	// ConditionSynced is true when the last reconcile against DataDog succeeded
+	ConditionSynced ConditionType = "Synced"
+	// ConditionError is true when the last reconcile against DataDog failed
+	ConditionError ConditionType = "Error"
+	// ConditionDrifted is true when the object was changed in DataDog outside of its spec
+	ConditionDrifted ConditionType = "Drifted"
+	// ConditionPaused is true while reconciling against DataDog is paused
+	ConditionPaused ConditionType = "Paused"
+	// ConditionMuted is true while the monitor is muted in DataDog by its spec
+	ConditionMuted ConditionType = "Muted"
+)
+
+// Condition describes the state of an object at a certain point
+type Condition struct {
+	Type               ConditionType          `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+	Reason             string                 `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+}
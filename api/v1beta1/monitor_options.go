@@ -0,0 +1,138 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MergedOptions returns Options with RawOptions merged over it as a JSON
+// object, or nil when neither is set.
+func (s *MonitorSpec) MergedOptions() ([]byte, error) {
+	if s.Options == nil && s.RawOptions == nil {
+		return nil, nil
+	}
+
+	merged := map[string]interface{}{}
+
+	if s.Options != nil {
+		typed, err := json.Marshal(s.Options)
+		if err != nil {
+			return nil, err
+		}
+
+		err = decodeJSON(typed, &merged)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if s.RawOptions != nil && len(s.RawOptions.Raw) > 0 {
+		var raw map[string]interface{}
+		err := decodeJSON(s.RawOptions.Raw, &raw)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range raw {
+			merged[key] = value
+		}
+	}
+
+	return json.Marshal(merged)
+}
+
+// SetOptions splits a JSON object of monitor options into Options and
+// RawOptions, an option is only moved into Options when it is represented
+// there exactly, everything else is kept in RawOptions.
+func (s *MonitorSpec) SetOptions(data []byte) error {
+	s.Options = nil
+	s.RawOptions = nil
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	var values map[string]json.RawMessage
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+
+	options := &MonitorOptions{}
+	raw := map[string]json.RawMessage{}
+
+	for key, value := range values {
+		if !setOption(options, key, value) {
+			raw[key] = value
+		}
+	}
+
+	s.Options = options
+
+	if len(raw) > 0 {
+		rawJSON, err := json.Marshal(raw)
+		if err != nil {
+			return err
+		}
+
+		s.RawOptions = &runtime.RawExtension{Raw: rawJSON}
+	}
+
+	return nil
+}
+
+// setOption sets key on options when its value decodes into the typed field
+// and encodes back to the same JSON.
+func setOption(options *MonitorOptions, key string, value json.RawMessage) bool {
+	option, err := json.Marshal(map[string]json.RawMessage{key: value})
+	if err != nil {
+		return false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(option))
+	decoder.DisallowUnknownFields()
+
+	typed := &MonitorOptions{}
+	if decoder.Decode(typed) != nil {
+		return false
+	}
+
+	encoded, err := json.Marshal(typed)
+	if err != nil {
+		return false
+	}
+
+	var want, got interface{}
+	if decodeJSON(option, &want) != nil || decodeJSON(encoded, &got) != nil || !reflect.DeepEqual(want, got) {
+		return false
+	}
+
+	return json.Unmarshal(option, options) == nil
+}
+
+// decodeJSON keeps numbers as they were written so values such as 1.0 and 1
+// are not treated as the same.
+func decodeJSON(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
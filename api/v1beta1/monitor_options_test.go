@@ -0,0 +1,75 @@
+package v1beta1_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+func float(f float64) *float64 { return &f }
+func integer(i int) *int       { return &i }
+func boolean(b bool) *bool     { return &b }
+
+func TestSetOptions(t *testing.T) {
+	tests := []struct {
+		options    string
+		expected   *monitoringv1beta1.MonitorOptions
+		rawOptions string
+	}{
+		{"", nil, ""},
+		{`{}`, &monitoringv1beta1.MonitorOptions{}, ""},
+		{
+			`{"thresholds": {"critical": 90, "warning": 80}, "notify_no_data": true, "renotify_interval": 10}`,
+			&monitoringv1beta1.MonitorOptions{
+				Thresholds:       &monitoringv1beta1.MonitorThresholds{Critical: float(90), Warning: float(80)},
+				NotifyNoData:     boolean(true),
+				RenotifyInterval: integer(10),
+			},
+			"",
+		},
+		{`{"notification_preset_name": "hide_query"}`, &monitoringv1beta1.MonitorOptions{}, `{"notification_preset_name":"hide_query"}`},
+		{`{"thresholds": {"critical": "90"}}`, &monitoringv1beta1.MonitorOptions{}, `{"thresholds":{"critical":"90"}}`},
+		{`{"thresholds": {"critical": 90.0}}`, &monitoringv1beta1.MonitorOptions{}, `{"thresholds":{"critical":90.0}}`},
+		{`{"locked": null, "include_tags": false}`, &monitoringv1beta1.MonitorOptions{IncludeTags: boolean(false)}, `{"locked":null}`},
+	}
+
+	for _, test := range tests {
+		spec := monitoringv1beta1.MonitorSpec{}
+		err := spec.SetOptions([]byte(test.options))
+		assert.NilError(t, err)
+
+		assert.DeepEqual(t, spec.Options, test.expected)
+		if test.rawOptions == "" {
+			assert.Assert(t, spec.RawOptions == nil)
+		} else {
+			assert.Equal(t, string(spec.RawOptions.Raw), test.rawOptions)
+		}
+	}
+}
+
+func TestMergedOptions(t *testing.T) {
+	tests := []struct {
+		spec     monitoringv1beta1.MonitorSpec
+		expected string
+	}{
+		{monitoringv1beta1.MonitorSpec{}, ""},
+		{monitoringv1beta1.MonitorSpec{Options: &monitoringv1beta1.MonitorOptions{}}, `{}`},
+		{
+			monitoringv1beta1.MonitorSpec{
+				Options:    &monitoringv1beta1.MonitorOptions{NotifyNoData: boolean(true), TimeoutH: integer(1)},
+				RawOptions: &runtime.RawExtension{Raw: []byte(`{"timeout_h": 2.0, "notification_preset_name": "hide_query"}`)},
+			},
+			`{"notification_preset_name":"hide_query","notify_no_data":true,"timeout_h":2.0}`,
+		},
+	}
+
+	for _, test := range tests {
+		merged, err := test.spec.MergedOptions()
+
+		assert.NilError(t, err)
+		assert.Equal(t, string(merged), test.expected)
+	}
+}
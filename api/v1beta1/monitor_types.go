@@ -0,0 +1,220 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DriftPolicy decides what happens when a monitor is changed in DataDog
+// outside of its spec.
+// +kubebuilder:validation:Enum=Revert;Report
+type DriftPolicy string
+
+const (
+	// DriftPolicyRevert overwrites the changes made in DataDog with the spec
+	DriftPolicyRevert DriftPolicy = "Revert"
+	// DriftPolicyReport keeps the changes made in DataDog and sets the Drifted condition
+	DriftPolicyReport DriftPolicy = "Report"
+)
+
+// DeletionPolicy decides what happens to a monitor in DataDog when its
+// Kubernetes object is deleted.
+// +kubebuilder:validation:Enum=Delete;Orphan
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the monitor from DataDog
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+	// DeletionPolicyOrphan leaves the monitor in DataDog
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// PlannedAction is a change to DataDog skipped in dry run mode.
+type PlannedAction string
+
+const (
+	PlannedActionNone   PlannedAction = "None"
+	PlannedActionCreate PlannedAction = "Create"
+	PlannedActionAdopt  PlannedAction = "Adopt"
+	PlannedActionUpdate PlannedAction = "Update"
+	PlannedActionDelete PlannedAction = "Delete"
+	PlannedActionOrphan PlannedAction = "Orphan"
+)
+
+// MonitorThresholds are the values a monitor query is compared against
+type MonitorThresholds struct {
+	Critical         *float64 `json:"critical,omitempty"`
+	CriticalRecovery *float64 `json:"critical_recovery,omitempty"`
+	Warning          *float64 `json:"warning,omitempty"`
+	WarningRecovery  *float64 `json:"warning_recovery,omitempty"`
+	OK               *float64 `json:"ok,omitempty"`
+	Unknown          *float64 `json:"unknown,omitempty"`
+}
+
+// MonitorThresholdWindows are the time windows of an anomaly monitor
+type MonitorThresholdWindows struct {
+	RecoveryWindow *string `json:"recovery_window,omitempty"`
+	TriggerWindow  *string `json:"trigger_window,omitempty"`
+}
+
+// MonitorOptions are the options of a DataDog monitor, the field names match
+// the DataDog API.
+type MonitorOptions struct {
+	Thresholds       *MonitorThresholds       `json:"thresholds,omitempty"`
+	ThresholdWindows *MonitorThresholdWindows `json:"threshold_windows,omitempty"`
+	NotifyNoData     *bool                    `json:"notify_no_data,omitempty"`
+	// NoDataTimeframe is the number of minutes without data before notifying
+	// +kubebuilder:validation:Minimum=1
+	NoDataTimeframe *int `json:"no_data_timeframe,omitempty"`
+	// RenotifyInterval is the number of minutes before notifying again while
+	// the monitor is still alerting, 0 disables it
+	// +kubebuilder:validation:Minimum=0
+	RenotifyInterval *int `json:"renotify_interval,omitempty"`
+	// EvaluationDelay is the number of seconds to delay the evaluation by
+	// +kubebuilder:validation:Minimum=0
+	EvaluationDelay *int `json:"evaluation_delay,omitempty"`
+	// NewHostDelay is the number of seconds a new host has to start before
+	// it is evaluated
+	// +kubebuilder:validation:Minimum=0
+	NewHostDelay      *int    `json:"new_host_delay,omitempty"`
+	IncludeTags       *bool   `json:"include_tags,omitempty"`
+	RequireFullWindow *bool   `json:"require_full_window,omitempty"`
+	EscalationMessage *string `json:"escalation_message,omitempty"`
+	Locked            *bool   `json:"locked,omitempty"`
+	// Silenced maps the scopes that are muted to the UNIX time the mute
+	// ends, 0 mutes the scope until it is unmuted
+	Silenced map[string]int `json:"silenced,omitempty"`
+	// TimeoutH is the number of hours after which a monitor that stopped
+	// reporting data resolves
+	// +kubebuilder:validation:Minimum=0
+	TimeoutH *int `json:"timeout_h,omitempty"`
+}
+
+// MonitorSpec defines the desired state of Monitor
+type MonitorSpec struct {
+	// +kubebuilder:validation:Enum=composite;event alert;log alert;metric alert;process alert;query alert;rum alert;service check;slo alert;synthetics alert;trace-analytics alert
+	Type string `json:"type"`
+	// +kubebuilder:validation:MinLength=1
+	Query   string   `json:"query"`
+	Name    string   `json:"name"`
+	Message string   `json:"message"`
+	Tags    []string `json:"tags,omitempty"`
+
+	Options *MonitorOptions `json:"options,omitempty"`
+	// RawOptions is merged over Options as is, for options DataDog supports
+	// that Options does not cover yet.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	RawOptions *runtime.RawExtension `json:"rawOptions,omitempty"`
+
+	// AccountRef names a DatadogAccount in the same namespace to manage the
+	// monitor with, the operator credentials are used when not set.
+	AccountRef *corev1.LocalObjectReference `json:"accountRef,omitempty"`
+
+	// DriftPolicy decides what happens when the monitor is changed in
+	// DataDog outside of its spec, defaults to Revert.
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// DeletionPolicy decides whether the DataDog monitor is deleted along
+	// with the object, defaults to the operator --default-deletion-policy.
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// AdoptMonitorID takes over an existing DataDog monitor instead of
+	// creating a new one.
+	AdoptMonitorID int `json:"adoptMonitorID,omitempty"`
+
+	// Mute mutes the notifications of the monitor, applied to DataDog
+	// separately from the rest of the spec.
+	Mute *MonitorMute `json:"mute,omitempty"`
+}
+
+// MonitorMute mutes a monitor, entirely or for some scopes
+type MonitorMute struct {
+	// Scopes to mute, such as host:web-1, the whole monitor is muted when empty
+	Scopes []string `json:"scopes,omitempty"`
+	// End is when the mute ends, without it the monitor stays muted until
+	// the mute is removed from the spec
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// MutedScope is a scope of a monitor muted in DataDog
+type MutedScope struct {
+	// Scope is the muted scope, * when the whole monitor is muted
+	Scope string `json:"scope"`
+	// End is when the mute ends, not set when it lasts until unmuted
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// FieldDiff is a field changed by an update, with its old and new values as JSON
+type FieldDiff struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// MonitorStatus defines the observed state of Monitor
+type MonitorStatus struct {
+	MonitorID          int         `json:"monitorID"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	// LastSyncedTime is the time of the last successful sync with DataDog.
+	LastSyncedTime *metav1.Time `json:"lastSyncedTime,omitempty"`
+	// Site is the DataDog site the monitor was created in.
+	Site string `json:"site,omitempty"`
+	// PlannedAction is the change the operator would make in DataDog when
+	// running with --dry-run.
+	PlannedAction PlannedAction `json:"plannedAction,omitempty"`
+	// LastAppliedDiff lists the fields changed by the last update to the
+	// DataDog monitor.
+	LastAppliedDiff []FieldDiff `json:"lastAppliedDiff,omitempty"`
+	// MutedScopes is the mute state of the monitor in DataDog.
+	MutedScopes []MutedScope `json:"mutedScopes,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="ID",type="integer",JSONPath=".status.monitorID"
+// +kubebuilder:printcolumn:name="Site",type="string",JSONPath=".status.site",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+// +kubebuilder:printcolumn:name="Error",type="string",JSONPath=".status.conditions[?(@.type==\"Error\")].message"
+// +kubebuilder:printcolumn:name="Last Synced",type="date",JSONPath=".status.lastSyncedTime",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Monitor is the Schema for the monitors API
+type Monitor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MonitorSpec   `json:"spec,omitempty"`
+	Status MonitorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MonitorList contains a list of Monitor
+type MonitorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Monitor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Monitor{}, &MonitorList{})
+}
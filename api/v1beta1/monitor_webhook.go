@@ -0,0 +1,224 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// MonitorTypes are the monitor types accepted by DataDog
+var MonitorTypes = []string{
+	"composite",
+	"event alert",
+	"log alert",
+	"metric alert",
+	"process alert",
+	"query alert",
+	"rum alert",
+	"service check",
+	"slo alert",
+	"synthetics alert",
+	"trace-analytics alert",
+}
+
+var queryComparator = regexp.MustCompile(`(>=|<=|>|<)\s*(-?[0-9.]+)\s*$`)
+
+func (r *Monitor) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-monitoring-datadog-com-v1beta1-monitor,mutating=false,failurePolicy=fail,groups=monitoring.datadog.com,resources=monitors,versions=v1beta1,name=vmonitor.v1beta1.monitoring.datadog.com
+
+var _ webhook.Validator = &Monitor{}
+
+func (r *Monitor) ValidateCreate() error {
+	return r.validate()
+}
+
+// ValidateUpdate only validates changes to the spec, so monitors admitted
+// before a validation was added can still be finalized and deleted.
+func (r *Monitor) ValidateUpdate(old runtime.Object) error {
+	if r.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if oldMonitor, ok := old.(*Monitor); ok && apiequality.Semantic.DeepEqual(oldMonitor.Spec, r.Spec) {
+		return nil
+	}
+
+	return r.validate()
+}
+
+func (r *Monitor) ValidateDelete() error {
+	return nil
+}
+
+func (r *Monitor) validate() error {
+	errs := r.Spec.validate(field.NewPath("spec"))
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupVersion.Group, Kind: "Monitor"},
+		r.Name, errs)
+}
+
+func (s *MonitorSpec) validate(path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !IsMonitorType(s.Type) {
+		errs = append(errs, field.NotSupported(path.Child("type"), s.Type, MonitorTypes))
+	}
+
+	if strings.TrimSpace(s.Query) == "" {
+		errs = append(errs, field.Required(path.Child("query"), "query must not be empty"))
+	}
+
+	seen := map[string]bool{}
+	for i, tag := range s.Tags {
+		if seen[tag] {
+			errs = append(errs, field.Duplicate(path.Child("tags").Index(i), tag))
+		}
+		seen[tag] = true
+	}
+
+	errs = append(errs, s.validateOptions(path)...)
+
+	if s.Mute != nil {
+		errs = append(errs, ValidateMuteScopes(path.Child("mute", "scopes"), s.Mute.Scopes)...)
+	}
+
+	return errs
+}
+
+func (s *MonitorSpec) validateOptions(path *field.Path) field.ErrorList {
+	if s.RawOptions != nil {
+		var raw map[string]interface{}
+		err := json.Unmarshal(s.RawOptions.Raw, &raw)
+		if err != nil {
+			return field.ErrorList{field.Invalid(path.Child("rawOptions"), string(s.RawOptions.Raw), fmt.Sprintf("must be a JSON object: %v", err))}
+		}
+	}
+
+	merged, err := s.MergedOptions()
+	if err != nil || merged == nil {
+		return nil
+	}
+
+	var options struct {
+		Thresholds *MonitorThresholds `json:"thresholds"`
+		Silenced   map[string]int     `json:"silenced"`
+	}
+	err = json.Unmarshal(merged, &options)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path.Child("rawOptions"), string(s.RawOptions.Raw), err.Error())}
+	}
+
+	var errs field.ErrorList
+
+	if s.Mute != nil && options.Silenced != nil {
+		errs = append(errs, field.Forbidden(path.Child("mute"), "must not be set together with options.silenced"))
+	}
+
+	if options.Thresholds != nil {
+		errs = append(errs, ValidateThresholds(path.Child("options", "thresholds"), s.Query, options.Thresholds)...)
+	}
+
+	return errs
+}
+
+// ValidateMuteScopes checks the scopes to mute are set and listed once.
+func ValidateMuteScopes(path *field.Path, scopes []string) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := map[string]bool{}
+	for i, scope := range scopes {
+		if strings.TrimSpace(scope) == "" {
+			errs = append(errs, field.Required(path.Index(i), "scope must not be empty"))
+		} else if seen[scope] {
+			errs = append(errs, field.Duplicate(path.Index(i), scope))
+		}
+		seen[scope] = true
+	}
+
+	return errs
+}
+
+// ValidateThresholds checks the critical threshold matches the query and
+// the thresholds are ordered to match its comparator, warning comes before
+// critical and each recovery threshold before the threshold it recovers from.
+func ValidateThresholds(path *field.Path, query string, t *MonitorThresholds) field.ErrorList {
+	match := queryComparator.FindStringSubmatch(query)
+	if match == nil {
+		return nil
+	}
+
+	above := strings.HasPrefix(match[1], ">")
+
+	var errs field.ErrorList
+
+	queryThreshold, err := strconv.ParseFloat(match[2], 64)
+	if err == nil && t.Critical != nil && *t.Critical != queryThreshold {
+		errs = append(errs, field.Invalid(path.Child("critical"), *t.Critical,
+			fmt.Sprintf("must match the threshold of the query (%v)", queryThreshold)))
+	}
+
+	before := func(lower, higher *float64, lowerName, higherName string) {
+		if lower == nil || higher == nil {
+			return
+		}
+
+		if above && *lower >= *higher {
+			errs = append(errs, field.Invalid(path.Child(lowerName), *lower,
+				fmt.Sprintf("must be below %s (%v) for a %s query", higherName, *higher, match[1])))
+		} else if !above && *lower <= *higher {
+			errs = append(errs, field.Invalid(path.Child(lowerName), *lower,
+				fmt.Sprintf("must be above %s (%v) for a %s query", higherName, *higher, match[1])))
+		}
+	}
+
+	before(t.Warning, t.Critical, "warning", "critical")
+	before(t.CriticalRecovery, t.Critical, "critical_recovery", "critical")
+	before(t.WarningRecovery, t.Warning, "warning_recovery", "warning")
+
+	return errs
+}
+
+// IsMonitorType reports whether DataDog accepts the monitor type
+func IsMonitorType(monitorType string) bool {
+	for _, t := range MonitorTypes {
+		if t == monitorType {
+			return true
+		}
+	}
+
+	return false
+}
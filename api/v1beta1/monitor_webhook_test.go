@@ -0,0 +1,98 @@
+package v1beta1_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+func monitorWith(change func(spec *monitoringv1beta1.MonitorSpec)) *monitoringv1beta1.Monitor {
+	monitor := &monitoringv1beta1.Monitor{
+		Spec: monitoringv1beta1.MonitorSpec{
+			Type:    "metric alert",
+			Name:    "High CPU",
+			Message: "CPU is high",
+			Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+			Options: &monitoringv1beta1.MonitorOptions{
+				Thresholds: &monitoringv1beta1.MonitorThresholds{Critical: float(90), Warning: float(80)},
+			},
+		},
+	}
+
+	change(&monitor.Spec)
+
+	return monitor
+}
+
+func rawOptions(raw string) *runtime.RawExtension {
+	return &runtime.RawExtension{Raw: []byte(raw)}
+}
+
+func TestValidateMonitor(t *testing.T) {
+	tests := []struct {
+		monitor *monitoringv1beta1.Monitor
+		err     string
+	}{
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {}), ""},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Options = nil }), ""},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Type = "metric" }), `spec.type: Unsupported value: "metric"`},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Query = " " }), "spec.query: Required value"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Tags = []string{"a", "b", "a"} }), `spec.tags[2]: Duplicate value: "a"`},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.RawOptions = rawOptions(`[]`) }), "spec.rawOptions: Invalid value"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.RawOptions = rawOptions(`{"thresholds": {"critical": "90"}}`)
+		}), "spec.rawOptions: Invalid value"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Options.Thresholds.Warning = float(95) }), "spec.options.thresholds.warning: Invalid value: 95: must be below critical"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.RawOptions = rawOptions(`{"thresholds": {"critical": 95}}`)
+		}), "spec.options.thresholds.critical: Invalid value"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.RawOptions = rawOptions(`{"notification_preset_name": "hide_query"}`)
+		}), ""},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.Mute = &monitoringv1beta1.MonitorMute{Scopes: []string{"host:web-1", "host:web-2"}}
+		}), ""},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.Mute = &monitoringv1beta1.MonitorMute{Scopes: []string{"host:web-1", "host:web-1"}}
+		}), `spec.mute.scopes[1]: Duplicate value: "host:web-1"`},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.Mute = &monitoringv1beta1.MonitorMute{Scopes: []string{""}}
+		}), "spec.mute.scopes[0]: Required value"},
+		{monitorWith(func(spec *monitoringv1beta1.MonitorSpec) {
+			spec.Mute = &monitoringv1beta1.MonitorMute{}
+			spec.RawOptions = rawOptions(`{"silenced": {"*": 0}}`)
+		}), "spec.mute: Forbidden: must not be set together with options.silenced"},
+	}
+
+	for _, test := range tests {
+		err := test.monitor.ValidateCreate()
+
+		if test.err == "" {
+			assert.NilError(t, err)
+		} else {
+			assert.ErrorContains(t, err, test.err)
+		}
+	}
+}
+
+func TestValidateMonitorUpdate(t *testing.T) {
+	invalid := monitorWith(func(spec *monitoringv1beta1.MonitorSpec) { spec.Type = "metric" })
+
+	unchanged := invalid.DeepCopy()
+	unchanged.Finalizers = []string{"monitoring.datadog.com.monitor"}
+	assert.NilError(t, unchanged.ValidateUpdate(invalid))
+
+	now := metav1.Now()
+	deleting := invalid.DeepCopy()
+	deleting.DeletionTimestamp = &now
+	deleting.Spec.Name = "Changed"
+	assert.NilError(t, deleting.ValidateUpdate(invalid))
+
+	changed := invalid.DeepCopy()
+	changed.Spec.Name = "Changed"
+	assert.ErrorContains(t, changed.ValidateUpdate(invalid), `spec.type: Unsupported value: "metric"`)
+}
@@ -0,0 +1,165 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// export writes a Monitor manifest for each existing DataDog monitor so they
+// can be committed to git and adopted by the operator.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const maxNameLength = 50
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+type manifest struct {
+	APIVersion string                        `json:"apiVersion"`
+	Kind       string                        `json:"kind"`
+	Metadata   metadata                      `json:"metadata"`
+	Spec       monitoringv1beta1.MonitorSpec `json:"spec"`
+}
+
+type metadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type tagsFlag []string
+
+func (t *tagsFlag) String() string {
+	return strings.Join(*t, ",")
+}
+
+func (t *tagsFlag) Set(value string) error {
+	*t = append(*t, strings.Split(value, ",")...)
+	return nil
+}
+
+// objectName turns a monitor name into a valid Kubernetes name, the ID keeps
+// names unique.
+func objectName(monitor *datadog.Monitor) string {
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(monitor.GetName()), "-")
+	if len(name) > maxNameLength {
+		name = name[:maxNameLength]
+	}
+	name = strings.Trim(name, "-")
+
+	id := strconv.Itoa(monitor.GetId())
+	if name == "" {
+		return "monitor-" + id
+	}
+
+	return name + "-" + id
+}
+
+func export(monitor *datadog.Monitor, namespace, outputDir string) (string, error) {
+	spec, err := datadog.MonitorSpec(monitor)
+	if err != nil {
+		return "", err
+	}
+
+	name := objectName(monitor)
+
+	out, err := yaml.Marshal(manifest{
+		APIVersion: monitoringv1beta1.GroupVersion.String(),
+		Kind:       "Monitor",
+		Metadata:   metadata{Name: name, Namespace: namespace},
+		Spec:       spec,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(outputDir, name+".yaml")
+
+	return path, ioutil.WriteFile(path, out, 0644)
+}
+
+// exportMonitors writes a manifest for each DataDog monitor matching opts and
+// with a query containing query, printing the path of each to out.
+func exportMonitors(client *datadog.Client, opts datadog.MonitorQueryOpts, query, namespace, outputDir string, out io.Writer) error {
+	monitors, err := client.GetMonitors(opts)
+	if err != nil {
+		return fmt.Errorf("failed to list monitors: %w", err)
+	}
+
+	err = os.MkdirAll(outputDir, 0755)
+	if err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	for i := range monitors {
+		monitor := &monitors[i]
+
+		if !strings.Contains(monitor.GetQuery(), query) {
+			continue
+		}
+
+		path, err := export(monitor, namespace, outputDir)
+		if err != nil {
+			return fmt.Errorf("failed to export monitor %d: %w", monitor.GetId(), err)
+		}
+
+		fmt.Fprintln(out, path)
+	}
+
+	return nil
+}
+
+func main() {
+	var outputDir string
+	var namespace string
+	var name string
+	var query string
+	var site string
+	var tags tagsFlag
+	flag.StringVar(&outputDir, "output-dir", ".", "The directory to write the Monitor manifests to.")
+	flag.StringVar(&namespace, "namespace", "", "The namespace to set on the Monitor manifests.")
+	flag.StringVar(&name, "name", "", "Only export monitors with a name containing this text.")
+	flag.StringVar(&query, "query", "", "Only export monitors with a query containing this text.")
+	flag.Var(&tags, "tag", "Only export monitors with this tag, can be repeated or comma separated.")
+	flag.StringVar(&site, "site", "", "The DataDog site to use, defaults to DD_SITE or datadoghq.com.")
+	flag.Parse()
+
+	client := datadog.NewClient()
+	if site != "" {
+		client.SetSite(site)
+	}
+
+	opts := datadog.MonitorQueryOpts{MonitorTags: tags}
+	if name != "" {
+		opts.Name = &name
+	}
+
+	err := exportMonitors(client, opts, query, namespace, outputDir, os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
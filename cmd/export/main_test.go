@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+func newMonitor(name, query string, tags ...string) *datadog.Monitor {
+	monitor := &datadog.Monitor{}
+	monitor.SetType("metric alert")
+	monitor.SetName(name)
+	monitor.SetMessage("Notify @ops")
+	monitor.SetQuery(query)
+	monitor.Tags = tags
+
+	notifyNoData := true
+	monitor.Options = &datadog.Options{NotifyNoData: &notifyNoData}
+
+	return monitor
+}
+
+func TestExportMonitors(t *testing.T) {
+	server := ddfake.NewServer()
+	defer server.Close()
+
+	server.SetMonitor(newMonitor("High CPU", "avg(last_5m):avg:system.cpu.user{*} > 90", "team:payments"))
+	server.SetMonitor(newMonitor("High CPU on web", "avg(last_5m):avg:system.cpu.user{role:web} > 90", "team:web"))
+	server.SetMonitor(newMonitor("High memory", "avg(last_5m):avg:system.mem.used{*} > 90", "team:payments"))
+	server.SetMonitor(newMonitor("High CPU load", "avg(last_5m):avg:system.load.1{*} > 4", "team:payments"))
+
+	outputDir, err := ioutil.TempDir("", "export")
+	assert.NilError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	name := "cpu"
+	opts := datadog.MonitorQueryOpts{Name: &name, MonitorTags: []string{"team:payments"}}
+
+	out := &bytes.Buffer{}
+	err = exportMonitors(server.Client(), opts, "system.cpu", "monitoring", outputDir, out)
+	assert.NilError(t, err)
+
+	path := filepath.Join(outputDir, "high-cpu-1.yaml")
+	assert.Equal(t, out.String(), path+"\n")
+
+	manifest, err := ioutil.ReadFile(path)
+	assert.NilError(t, err)
+	assert.Equal(t, string(manifest), `apiVersion: monitoring.datadog.com/v1beta1
+kind: Monitor
+metadata:
+  name: high-cpu-1
+  namespace: monitoring
+spec:
+  adoptMonitorID: 1
+  message: Notify @ops
+  name: High CPU
+  options:
+    notify_no_data: true
+  query: avg(last_5m):avg:system.cpu.user{*} > 90
+  tags:
+  - team:payments
+  type: metric alert
+`)
+
+	files, err := ioutil.ReadDir(outputDir)
+	assert.NilError(t, err)
+	assert.Equal(t, len(files), 1)
+
+	calls := []string{}
+	for _, call := range server.Calls() {
+		calls = append(calls, call.String())
+	}
+	assert.DeepEqual(t, calls, []string{"GET /api/v1/monitor"})
+}
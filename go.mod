@@ -5,14 +5,19 @@ go 1.13
 require (
 	github.com/cenkalti/backoff v2.2.1+incompatible // indirect
 	github.com/go-logr/logr v0.1.0
+	github.com/google/gofuzz v0.0.0-20170612174753-24818f796faf
 	github.com/google/go-cmp v0.3.1 // indirect
 	github.com/mitchellh/hashstructure v1.0.0
 	github.com/onsi/ginkgo v1.8.0
 	github.com/onsi/gomega v1.5.0
+	github.com/prometheus/client_golang v0.9.0
 	github.com/zorkian/go-datadog-api v2.24.0+incompatible
+	golang.org/x/time v0.0.0-20180412165947-fbb02b2291d2
 	gotest.tools v2.2.0+incompatible
+	k8s.io/api v0.0.0-20190409021203-6e4e0e4f393b
 	k8s.io/apimachinery v0.0.0-20190404173353-6a84e37a896d
 	k8s.io/client-go v11.0.1-0.20190409021438-1a26190bd76a+incompatible
 	sigs.k8s.io/controller-runtime v0.2.2
 	sigs.k8s.io/controller-tools v0.2.1 // indirect
+	sigs.k8s.io/yaml v1.1.0
 )
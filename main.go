@@ -18,15 +18,22 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
 	"github.com/stefansedich/datadog-operator/pkg/controllers"
 	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	"github.com/stefansedich/datadog-operator/pkg/health"
+	"github.com/stefansedich/datadog-operator/pkg/tags"
+	"github.com/stefansedich/datadog-operator/pkg/webhooks"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -39,19 +46,73 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 
 	_ = monitoringv1alpha1.AddToScheme(scheme)
+	_ = monitoringv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
 func main() {
 	var metricsAddr string
+	var healthAddr string
 	var enableLeaderElection bool
+	var credentialsSecret types.NamespacedName
+	var site string
+	var resyncInterval time.Duration
+	var defaultDeletionPolicy string
+	var dryRun bool
+	var enableWebhooks bool
+	var clusterName string
+	var namespaceLabelTags string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
+	flag.StringVar(&healthAddr, "health-addr", ":8081", "The address the health and readiness endpoints bind to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
+	flag.StringVar(&credentialsSecret.Name, "credentials-secret-name", "",
+		"The Secret holding the DD_API_KEY and DD_APPLICATION_KEY used to call DataDog. When unset the keys are read from the environment.")
+	flag.StringVar(&credentialsSecret.Namespace, "credentials-secret-namespace", "",
+		"The namespace of the credentials Secret.")
+	flag.DurationVar(&resyncInterval, "resync-interval", 0,
+		"How often each monitor is checked against DataDog for changes made outside of its spec, 0 disables it. Can be overridden per monitor with the monitoring.datadog.com/resync-interval annotation.")
+	flag.StringVar(&defaultDeletionPolicy, "default-deletion-policy", string(monitoringv1beta1.DeletionPolicyDelete),
+		"Whether DataDog monitors are deleted along with their Monitor, Delete or Orphan. Can be overridden per monitor with spec.deletionPolicy.")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"Make no changes in DataDog. Monitors record the changes that would be made in their status and events, other resources log them.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", os.Getenv("ENABLE_WEBHOOKS") != "false",
+		"Serve the Monitor conversion and admission webhooks, requires a serving certificate. v1alpha1 Monitors cannot be read or written without the conversion webhook. Enabled unless the ENABLE_WEBHOOKS environment variable is false.")
+	flag.StringVar(&clusterName, "cluster-name", "", "The name of the cluster, added to monitors as the kube_cluster tag.")
+	flag.StringVar(&namespaceLabelTags, "namespace-label-tags", "",
+		"Comma separated namespace labels to add to monitors as tags, as label or label=tag.")
+	flag.StringVar(&site, "site", "",
+		"The DataDog site to use, such as datadoghq.com or datadoghq.eu. Defaults to the DD_SITE environment variable or datadoghq.com.")
+	flag.Float64Var(&datadog.RequestsPerSecond, "datadog-requests-per-second", datadog.RequestsPerSecond,
+		"The rate DataDog API requests are spread over, shared by all controllers. Zero disables the limit.")
+	flag.IntVar(&datadog.Burst, "datadog-burst", datadog.Burst, "The number of DataDog API requests that can be made at once.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.Logger(false))
 
+	if credentialsSecret.Name != "" && credentialsSecret.Namespace == "" {
+		setupLog.Error(nil, "--credentials-secret-namespace is required with --credentials-secret-name")
+		os.Exit(1)
+	}
+
+	switch monitoringv1beta1.DeletionPolicy(defaultDeletionPolicy) {
+	case monitoringv1beta1.DeletionPolicyDelete, monitoringv1beta1.DeletionPolicyOrphan:
+	default:
+		setupLog.Error(nil, "--default-deletion-policy must be Delete or Orphan", "value", defaultDeletionPolicy)
+		os.Exit(1)
+	}
+
+	namespaceLabels, err := tags.ParseNamespaceLabels(namespaceLabelTags)
+	if err != nil {
+		setupLog.Error(err, "invalid --namespace-label-tags")
+		os.Exit(1)
+	}
+
+	defaultTags := &tags.Defaulter{
+		ClusterName:     clusterName,
+		NamespaceLabels: namespaceLabels,
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:             scheme,
 		MetricsBindAddress: metricsAddr,
@@ -63,14 +124,105 @@ func main() {
 		os.Exit(1)
 	}
 
+	ddClient := datadog.NewClient()
+	if site != "" {
+		ddClient.SetSite(site)
+	}
+
+	if err = mgr.Add(&controllers.CredentialsWatcher{
+		Config:        mgr.GetConfig(),
+		Scheme:        mgr.GetScheme(),
+		Log:           ctrl.Log.WithName("credentials"),
+		Secret:        credentialsSecret,
+		DataDogClient: ddClient,
+	}); err != nil {
+		setupLog.Error(err, "unable to add credentials watcher")
+		os.Exit(1)
+	}
+	if err = mgr.Add(&health.Server{
+		Addr: healthAddr,
+		Log:  ctrl.Log.WithName("health"),
+	}); err != nil {
+		setupLog.Error(err, "unable to add health server")
+		os.Exit(1)
+	}
+
+	// The mutating webhook adds the default tags as monitors are applied, the
+	// controller only rewrites specs to add them when it is not served.
+	var controllerTags *tags.Defaulter
+	if !enableWebhooks {
+		controllerTags = defaultTags
+	}
+
 	if err = (&controllers.MonitorReconciler{
-		Client:        mgr.GetClient(),
-		Log:           ctrl.Log.WithName("controllers").WithName("Monitor"),
-		DataDogClient: datadog.NewClient(),
+		Client:                mgr.GetClient(),
+		Log:                   ctrl.Log.WithName("controllers").WithName("Monitor"),
+		Recorder:              mgr.GetEventRecorderFor("monitor-controller"),
+		DataDogClient:         ddClient,
+		ResyncInterval:        resyncInterval,
+		DefaultDeletionPolicy: monitoringv1beta1.DeletionPolicy(defaultDeletionPolicy),
+		DryRun:                dryRun,
+		DefaultTags:           controllerTags,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Monitor")
 		os.Exit(1)
 	}
+	if err = (&controllers.DashboardReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("Dashboard"),
+		Recorder:      mgr.GetEventRecorderFor("dashboard-controller"),
+		DataDogClient: ddClient,
+		DryRun:        dryRun,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Dashboard")
+		os.Exit(1)
+	}
+	if err = (&controllers.DowntimeReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("Downtime"),
+		Recorder:      mgr.GetEventRecorderFor("downtime-controller"),
+		DataDogClient: ddClient,
+		DryRun:        dryRun,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Downtime")
+		os.Exit(1)
+	}
+	if err = (&controllers.ServiceLevelObjectiveReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("ServiceLevelObjective"),
+		Recorder:      mgr.GetEventRecorderFor("servicelevelobjective-controller"),
+		DataDogClient: ddClient,
+		DryRun:        dryRun,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ServiceLevelObjective")
+		os.Exit(1)
+	}
+	if err = (&controllers.SyntheticsTestReconciler{
+		Client:        mgr.GetClient(),
+		Log:           ctrl.Log.WithName("controllers").WithName("SyntheticsTest"),
+		Recorder:      mgr.GetEventRecorderFor("syntheticstest-controller"),
+		DataDogClient: ddClient,
+		DryRun:        dryRun,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "SyntheticsTest")
+		os.Exit(1)
+	}
+	if !enableWebhooks {
+		setupLog.Info("Webhooks disabled, v1alpha1 Monitors are not converted and cannot be read or written")
+	} else {
+		if err = (&monitoringv1alpha1.Monitor{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Monitor")
+			os.Exit(1)
+		}
+		if err = (&monitoringv1beta1.Monitor{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Monitor")
+			os.Exit(1)
+		}
+		mgr.GetWebhookServer().Register(webhooks.MonitorTagsPath, &webhook.Admission{Handler: &webhooks.MonitorTags{
+			Client: mgr.GetClient(),
+			Tags:   defaultTags,
+		}})
+	}
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
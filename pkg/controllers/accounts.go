@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+type accountClient struct {
+	client *datadog.Client
+	keys   [2]string
+	site   string
+}
+
+// accountClients caches a DataDog client per DatadogAccount, picking up any
+// change to the keys in the referenced Secret on each lookup.
+type accountClients struct {
+	mu      sync.Mutex
+	clients map[types.NamespacedName]*accountClient
+}
+
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=datadogaccounts,verbs=get;list;watch
+
+// isAccountNotFound reports whether the DatadogAccount or its Secret is gone.
+func isAccountNotFound(err error) bool {
+	var statusErr *apierrs.StatusError
+
+	return errors.As(err, &statusErr) && apierrs.IsNotFound(statusErr)
+}
+
+// get returns the client for the referenced account, or fallback when ref is nil.
+func (a *accountClients) get(c client.Client, namespace string, ref *corev1.LocalObjectReference, fallback datadog.MonitorAPI) (datadog.MonitorAPI, error) {
+	if ref == nil {
+		return fallback, nil
+	}
+
+	ctx := context.Background()
+	name := types.NamespacedName{Namespace: namespace, Name: ref.Name}
+
+	account := &monitoringv1alpha1.DatadogAccount{}
+	err := c.Get(ctx, name, account)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			a.forget(name)
+		}
+
+		return nil, fmt.Errorf("failed to get DatadogAccount %s: %w", name, err)
+	}
+
+	secretName := types.NamespacedName{Namespace: namespace, Name: account.Spec.SecretRef.Name}
+
+	secret := &corev1.Secret{}
+	err = c.Get(ctx, secretName, secret)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			a.forget(name)
+		}
+
+		return nil, fmt.Errorf("failed to get Secret %s of DatadogAccount %s: %w", secretName, name, err)
+	}
+
+	keys := [2]string{
+		string(secret.Data[datadog.APIKeyName]),
+		string(secret.Data[datadog.ApplicationKeyName]),
+	}
+
+	if keys[0] == "" || keys[1] == "" {
+		return nil, fmt.Errorf("Secret %s of DatadogAccount %s must contain %s and %s",
+			secretName, name, datadog.APIKeyName, datadog.ApplicationKeyName)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.clients == nil {
+		a.clients = map[types.NamespacedName]*accountClient{}
+	}
+
+	cached, ok := a.clients[name]
+	if !ok {
+		cached = &accountClient{client: datadog.NewClient()}
+		a.clients[name] = cached
+	}
+
+	site := account.Spec.Site
+	if site == "" {
+		site = fallback.Site()
+	}
+
+	if cached.site != site {
+		cached.client.SetSite(site)
+		cached.site = site
+	}
+
+	if cached.keys != keys {
+		cached.client.SetKeys(keys[0], keys[1])
+		cached.keys = keys
+	}
+
+	return cached.client, nil
+}
+
+// forget drops the cached client of an account that is gone.
+func (a *accountClients) forget(name types.NamespacedName) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.clients, name)
+}
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+var accountName = types.NamespacedName{Namespace: "default", Name: "team-a"}
+
+func newAccountClient(t *testing.T) client.Client {
+	scheme := newTestScheme(t)
+
+	account := &monitoringv1alpha1.DatadogAccount{
+		ObjectMeta: metav1.ObjectMeta{Namespace: accountName.Namespace, Name: accountName.Name},
+		Spec: monitoringv1alpha1.DatadogAccountSpec{
+			SecretRef: corev1.LocalObjectReference{Name: "team-a-keys"},
+			Site:      "datadoghq.eu",
+		},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: accountName.Namespace, Name: "team-a-keys"},
+		Data: map[string][]byte{
+			datadog.APIKeyName:         []byte("api-key"),
+			datadog.ApplicationKeyName: []byte("application-key"),
+		},
+	}
+
+	return fake.NewFakeClientWithScheme(scheme, account, secret)
+}
+
+func TestAccountClientsGet(t *testing.T) {
+	c := newAccountClient(t)
+	fallback := ddfake.NewServer().Client()
+	ref := &corev1.LocalObjectReference{Name: accountName.Name}
+	accounts := &accountClients{}
+
+	ddClient, err := accounts.get(c, accountName.Namespace, nil, fallback)
+	assert.NilError(t, err)
+	assert.Equal(t, ddClient, datadog.MonitorAPI(fallback))
+
+	ddClient, err = accounts.get(c, accountName.Namespace, ref, fallback)
+	assert.NilError(t, err)
+	assert.Equal(t, ddClient.Site(), "datadoghq.eu")
+	assert.Equal(t, accounts.clients[accountName].keys, [2]string{"api-key", "application-key"})
+
+	cached, err := accounts.get(c, accountName.Namespace, ref, fallback)
+	assert.NilError(t, err)
+	assert.Equal(t, cached, ddClient)
+
+	ctx := context.Background()
+
+	secret := &corev1.Secret{}
+	assert.NilError(t, c.Get(ctx, types.NamespacedName{Namespace: accountName.Namespace, Name: "team-a-keys"}, secret))
+	secret.Data[datadog.APIKeyName] = []byte("rotated-api-key")
+	assert.NilError(t, c.Update(ctx, secret))
+
+	account := &monitoringv1alpha1.DatadogAccount{}
+	assert.NilError(t, c.Get(ctx, accountName, account))
+	account.Spec.Site = "us3.datadoghq.com"
+	assert.NilError(t, c.Update(ctx, account))
+
+	updated, err := accounts.get(c, accountName.Namespace, ref, fallback)
+	assert.NilError(t, err)
+	assert.Equal(t, updated, ddClient)
+	assert.Equal(t, updated.Site(), "us3.datadoghq.com")
+	assert.Equal(t, accounts.clients[accountName].keys, [2]string{"rotated-api-key", "application-key"})
+}
+
+func TestAccountClientsForgetsDeletedAccount(t *testing.T) {
+	c := newAccountClient(t)
+	fallback := ddfake.NewServer().Client()
+	ref := &corev1.LocalObjectReference{Name: accountName.Name}
+	accounts := &accountClients{}
+
+	_, err := accounts.get(c, accountName.Namespace, ref, fallback)
+	assert.NilError(t, err)
+	assert.Equal(t, len(accounts.clients), 1)
+
+	account := &monitoringv1alpha1.DatadogAccount{}
+	assert.NilError(t, c.Get(context.Background(), accountName, account))
+	assert.NilError(t, c.Delete(context.Background(), account))
+
+	_, err = accounts.get(c, accountName.Namespace, ref, fallback)
+	assert.Assert(t, isAccountNotFound(err))
+	assert.Equal(t, len(accounts.clients), 0)
+}
+
+func TestIsAccountNotFound(t *testing.T) {
+	c := newAccountClient(t)
+
+	err := c.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "removed"}, &monitoringv1alpha1.DatadogAccount{})
+	assert.Assert(t, isAccountNotFound(err))
+	assert.Assert(t, isAccountNotFound(fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", err))))
+	assert.Assert(t, !isAccountNotFound(fmt.Errorf("outer: %v", err)))
+}
@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	reasonCreated       = "Created"
+	reasonUpdated       = "Updated"
+	reasonUnchanged     = "Unchanged"
+	reasonRecreated     = "Recreated"
+	reasonDeleted       = "Deleted"
+	reasonBadRequest    = "BadRequest"
+	reasonForbidden     = "Forbidden"
+	reasonAPIError      = "APIError"
+	reasonRateLimited   = "RateLimited"
+	reasonAccountError  = "AccountError"
+	reasonDrifted       = "Drifted"
+	reasonAdopted       = "Adopted"
+	reasonAdoptConflict = "AdoptConflict"
+	reasonOrphaned      = "Orphaned"
+	reasonDryRun        = "DryRun"
+	reasonDriftReverted = "DriftReverted"
+	reasonPaused        = "Paused"
+	reasonResumed       = "Resumed"
+	reasonMuted         = "Muted"
+	reasonUnmuted       = "Unmuted"
+	reasonSynced        = "Synced"
+)
+
+func getCondition(conditions []monitoringv1beta1.Condition, conditionType monitoringv1beta1.ConditionType) *monitoringv1beta1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// setCondition adds or updates the condition of the given type, the
+// transition time only moves when the status changes.
+func setCondition(conditions []monitoringv1beta1.Condition, conditionType monitoringv1beta1.ConditionType, status corev1.ConditionStatus, reason, message string) []monitoringv1beta1.Condition {
+	condition := getCondition(conditions, conditionType)
+	if condition == nil {
+		conditions = append(conditions, monitoringv1beta1.Condition{Type: conditionType})
+		condition = &conditions[len(conditions)-1]
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+
+	return conditions
+}
+
+// setResourceCondition is setCondition for the v1alpha1 conditions of
+// dashboards, downtimes, service level objectives and synthetics tests.
+func setResourceCondition(conditions []monitoringv1alpha1.Condition, conditionType monitoringv1alpha1.ConditionType, status corev1.ConditionStatus, reason, message string) []monitoringv1alpha1.Condition {
+	var condition *monitoringv1alpha1.Condition
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			condition = &conditions[i]
+			break
+		}
+	}
+
+	if condition == nil {
+		conditions = append(conditions, monitoringv1alpha1.Condition{Type: conditionType})
+		condition = &conditions[len(conditions)-1]
+	}
+
+	if condition.Status != status {
+		condition.LastTransitionTime = metav1.Now()
+	}
+
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+
+	return conditions
+}
+
+// setResourceSynced marks a resource as in sync with DataDog, returning false
+// when its conditions already said so and there is no status to update.
+func setResourceSynced(conditions *[]monitoringv1alpha1.Condition) bool {
+	observed := append([]monitoringv1alpha1.Condition{}, *conditions...)
+
+	*conditions = setResourceCondition(*conditions, monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced, "")
+	*conditions = setResourceCondition(*conditions, monitoringv1alpha1.ConditionError, corev1.ConditionFalse, reasonSynced, "")
+
+	return !equality.Semantic.DeepEqual(observed, *conditions)
+}
+
+// setResourceError records a failed sync with DataDog in the conditions of a
+// resource.
+func setResourceError(conditions *[]monitoringv1alpha1.Condition, reason string, err error) {
+	message := datadog.ErrorReason(err)
+
+	*conditions = setResourceCondition(*conditions, monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reason, message)
+	*conditions = setResourceCondition(*conditions, monitoringv1alpha1.ConditionError, corev1.ConditionTrue, reason, message)
+}
+
+// setResourcePlanned records a change a dry run would make to DataDog in the
+// Ready condition of a resource, returning false when it was already recorded.
+func setResourcePlanned(conditions *[]monitoringv1alpha1.Condition, message string) bool {
+	observed := append([]monitoringv1alpha1.Condition{}, *conditions...)
+
+	*conditions = setResourceCondition(*conditions, monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonDryRun, message)
+
+	return !equality.Semantic.DeepEqual(observed, *conditions)
+}
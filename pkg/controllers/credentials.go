@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	credentialsRetryInterval = time.Minute
+)
+
+// CredentialsWatcher keeps the keys of the DataDog client in sync with a
+// Secret and validates them whenever they change. With no Secret configured
+// the keys from the environment are validated instead. The result is exported
+// as the datadog_credentials_valid metric, it runs on every replica so the
+// metric is reported everywhere, not only on the leader.
+//
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+type CredentialsWatcher struct {
+	Config        *rest.Config
+	Scheme        *runtime.Scheme
+	Log           logr.Logger
+	Secret        types.NamespacedName
+	DataDogClient *datadog.Client
+
+	keys  chan [2]string
+	known [2]string
+}
+
+func (w *CredentialsWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+func (w *CredentialsWatcher) onSecret(obj interface{}) {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok || secret.Name != w.Secret.Name {
+		return
+	}
+
+	w.keys <- [2]string{
+		string(secret.Data[datadog.APIKeyName]),
+		string(secret.Data[datadog.ApplicationKeyName]),
+	}
+}
+
+func (w *CredentialsWatcher) watchSecret(stop <-chan struct{}) error {
+	secrets, err := cache.New(w.Config, cache.Options{
+		Scheme:    w.Scheme,
+		Namespace: w.Secret.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	informer, err := secrets.GetInformer(&corev1.Secret{})
+	if err != nil {
+		return err
+	}
+
+	informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc: w.onSecret,
+		UpdateFunc: func(_, obj interface{}) {
+			w.onSecret(obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if secret, ok := obj.(*corev1.Secret); ok && secret.Name == w.Secret.Name {
+				w.Log.Info("Credentials secret deleted, keeping current keys", "secret", w.Secret)
+			}
+		},
+	})
+
+	go secrets.Start(stop)
+
+	if !secrets.WaitForCacheSync(stop) {
+		return errors.New("failed to sync credentials secret cache")
+	}
+
+	return nil
+}
+
+func (w *CredentialsWatcher) setKeys(keys [2]string) {
+	log := w.Log.WithValues("secret", w.Secret)
+
+	if keys == w.known {
+		return
+	}
+	w.known = keys
+
+	if keys[0] == "" || keys[1] == "" {
+		log.Error(nil, "Credentials secret is missing keys", "keys", []string{datadog.APIKeyName, datadog.ApplicationKeyName})
+	}
+
+	log.Info("Reloading DataDog credentials")
+
+	w.DataDogClient.SetKeys(keys[0], keys[1])
+	credentialsValid.Set(0)
+	w.validate()
+}
+
+func (w *CredentialsWatcher) validate() {
+	err := w.DataDogClient.Validate()
+	if err != nil {
+		credentialsValid.Set(0)
+		w.Log.Error(err, "Failed to validate DataDog credentials")
+		return
+	}
+
+	credentialsValid.Set(1)
+	w.Log.Info("Validated DataDog credentials")
+}
+
+func (w *CredentialsWatcher) Start(stop <-chan struct{}) error {
+	w.keys = make(chan [2]string)
+
+	if w.Secret.Name != "" {
+		if err := w.watchSecret(stop); err != nil {
+			return err
+		}
+	} else {
+		w.validate()
+	}
+
+	retry := time.NewTicker(credentialsRetryInterval)
+	defer retry.Stop()
+
+	for {
+		select {
+		case keys := <-w.keys:
+			w.setKeys(keys)
+		case <-retry.C:
+			if w.DataDogClient.Ready() != nil {
+				w.validate()
+			}
+		case <-stop:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func TestCredentialsWatcherValidate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api_key") != "valid" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors": ["Forbidden"]}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"valid": true}`)
+	}))
+	defer server.Close()
+
+	client := datadog.NewClient()
+	client.SetBaseUrl(server.URL)
+
+	watcher := &CredentialsWatcher{
+		Log:           ctrl.Log.WithName("test"),
+		DataDogClient: client,
+	}
+
+	watcher.setKeys([2]string{"valid", "app"})
+	assert.Equal(t, testutil.ToFloat64(credentialsValid), float64(1))
+
+	watcher.setKeys([2]string{"revoked", "app"})
+	assert.Equal(t, testutil.ToFloat64(credentialsValid), float64(0))
+}
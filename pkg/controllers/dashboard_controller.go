@@ -0,0 +1,268 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	dashboardFinalizerName = "monitoring.datadog.com.dashboard"
+)
+
+// DashboardReconciler reconciles a Dashboard object
+type DashboardReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	DataDogClient *datadog.Client
+	// DryRun logs what would be changed in DataDog without changing it.
+	DryRun bool
+}
+
+func isDashboardBeingCreated(dashboard *monitoringv1alpha1.Dashboard) bool {
+	return dashboard.Status.DashboardID == ""
+}
+
+func (r *DashboardReconciler) createDashboard(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues("dashboard", req.NamespacedName)
+
+	log.Info("Creating dashboard")
+
+	ddBoard := &datadog.Board{}
+	_, err := datadog.ChangeBoard(ddBoard, dashboard)
+	if err != nil {
+		return err
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would create dashboard")
+
+		return nil
+	}
+
+	newDDBoard, err := client.CreateBoard(ddBoard)
+	if err != nil {
+		return err
+	}
+
+	dashboard.Status.DashboardID = *newDDBoard.Id
+
+	err = r.Status().Update(context.Background(), dashboard)
+	if err != nil {
+		return err
+	}
+
+	addFinalizer(&dashboard.ObjectMeta, dashboardFinalizerName)
+
+	err = r.Update(context.Background(), dashboard)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully created dashboard", "dashboard_id", *newDDBoard.Id)
+
+	return nil
+}
+
+func (r *DashboardReconciler) updateDashboard(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"dashboard",
+		req.NamespacedName,
+		"dashboard_id",
+		dashboard.Status.DashboardID,
+	)
+
+	log.Info("Updating dashboard")
+
+	ddBoard, err := client.GetBoard(dashboard.Status.DashboardID)
+	if err != nil {
+		if datadog.IsNotFound(err) {
+			log.Info("Existing dashboard not found, creating again")
+
+			dashboard.Status.DashboardID = ""
+
+			return r.createDashboard(req, dashboard)
+		}
+
+		return err
+	}
+
+	changed, err := datadog.ChangeBoard(ddBoard, dashboard)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		log.Info("Skipping update of unchanged dashboard")
+
+		return nil
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would update dashboard")
+
+		return nil
+	}
+
+	err = client.UpdateBoard(ddBoard)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully updated dashboard")
+
+	return nil
+}
+
+func (r *DashboardReconciler) deleteDashboard(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"dashboard",
+		req.NamespacedName,
+		"dashboard_id",
+		dashboard.Status.DashboardID,
+	)
+
+	if r.DryRun {
+		return r.planDeletion(req, dashboard)
+	}
+
+	log.Info("Deleting dashboard")
+
+	err := client.DeleteBoard(dashboard.Status.DashboardID)
+	if err != nil {
+		return datadog.IgnoreNotFound(err)
+	}
+
+	removeFinalizer(&dashboard.ObjectMeta, dashboardFinalizerName)
+
+	err = r.Update(context.Background(), dashboard)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully deleted dashboard")
+
+	return nil
+}
+
+// planDeletion records the deletion of the dashboard without touching DataDog.
+// The finalizer is kept so the deletion completes once the operator runs
+// without --dry-run.
+func (r *DashboardReconciler) planDeletion(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard) error {
+	message := fmt.Sprintf("Would delete dashboard %s", dashboard.Status.DashboardID)
+
+	r.Log.Info("Dry run", "dashboard", req.NamespacedName, "message", message)
+
+	if !setResourcePlanned(&dashboard.Status.Conditions, message) {
+		return nil
+	}
+
+	r.Recorder.Event(dashboard, corev1.EventTypeNormal, reasonDryRun, message)
+
+	return r.Status().Update(context.Background(), dashboard)
+}
+
+func (r *DashboardReconciler) handleError(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard, err error) (ctrl.Result, error) {
+	log := r.Log.WithValues("dashboard", req.NamespacedName)
+
+	if datadog.IsBadRequest(err) {
+		log.Error(err, "Bad request to DataDog API", "reason", datadog.ErrorReason(err))
+
+		r.Recorder.Eventf(dashboard, corev1.EventTypeWarning, reasonBadRequest, "DataDog API rejected the dashboard: %s", datadog.ErrorReason(err))
+
+		r.setError(req, dashboard, reasonBadRequest, err)
+
+		return ctrl.Result{}, nil
+	} else if datadog.IsForbidden(err) {
+		log.Error(nil, "Failed to authenticate with DataDog API")
+
+		r.Recorder.Event(dashboard, corev1.EventTypeWarning, reasonForbidden, "Failed to authenticate with DataDog API")
+
+		r.setError(req, dashboard, reasonForbidden, err)
+
+		return ctrl.Result{}, nil
+	} else {
+		return ctrl.Result{}, err
+	}
+}
+
+// setError records a failed sync with DataDog in the dashboard status.
+func (r *DashboardReconciler) setError(req ctrl.Request, dashboard *monitoringv1alpha1.Dashboard, reason string, err error) {
+	setResourceError(&dashboard.Status.Conditions, reason, err)
+
+	updateErr := r.Status().Update(context.Background(), dashboard)
+	if updateErr != nil {
+		r.Log.Error(updateErr, "Failed to update dashboard status", "dashboard", req.NamespacedName)
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=dashboards,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=dashboards/status,verbs=get;update;patch
+
+func (r *DashboardReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	dashboard := &monitoringv1alpha1.Dashboard{}
+	err := r.Get(ctx, req.NamespacedName, dashboard)
+	if err != nil {
+		return ctrl.Result{}, ignoreNotFound(err)
+	}
+
+	if isBeingDeleted(&dashboard.ObjectMeta, dashboardFinalizerName) {
+		err := r.deleteDashboard(req, dashboard)
+		if err != nil {
+			return r.handleError(req, dashboard, err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if isDashboardBeingCreated(dashboard) {
+		err = r.createDashboard(req, dashboard)
+	} else {
+		err = r.updateDashboard(req, dashboard)
+	}
+
+	if err != nil {
+		return r.handleError(req, dashboard, err)
+	}
+
+	if !r.DryRun && setResourceSynced(&dashboard.Status.Conditions) {
+		err = r.Status().Update(ctx, dashboard)
+	}
+
+	return ctrl.Result{}, err
+}
+
+func (r *DashboardReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&monitoringv1alpha1.Dashboard{}).
+		Complete(r)
+}
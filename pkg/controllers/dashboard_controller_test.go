@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+var dashboardName = types.NamespacedName{Namespace: "default", Name: "overview"}
+
+func newTestDashboard() *monitoringv1alpha1.Dashboard {
+	return &monitoringv1alpha1.Dashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: dashboardName.Namespace,
+			Name:      dashboardName.Name,
+		},
+		Spec: monitoringv1alpha1.DashboardSpec{
+			Title:      "Overview",
+			LayoutType: "ordered",
+			Widgets: []monitoringv1alpha1.DashboardWidget{{
+				Definition: &runtime.RawExtension{Raw: []byte(`{"type": "timeseries", "requests": [{"q": "avg:system.cpu.user{*}"}]}`)},
+			}},
+		},
+	}
+}
+
+type dashboardTest struct {
+	t          *testing.T
+	server     *ddfake.Server
+	client     client.Client
+	recorder   *record.FakeRecorder
+	reconciler *DashboardReconciler
+}
+
+func newDashboardTest(t *testing.T, objs ...runtime.Object) *dashboardTest {
+	server := ddfake.NewServer()
+	c := fake.NewFakeClientWithScheme(newTestScheme(t), objs...)
+	recorder := record.NewFakeRecorder(100)
+
+	return &dashboardTest{
+		t:        t,
+		server:   server,
+		client:   c,
+		recorder: recorder,
+		reconciler: &DashboardReconciler{
+			Client:        c,
+			Log:           ctrl.Log.WithName("test"),
+			Recorder:      recorder,
+			DataDogClient: server.Client(),
+		},
+	}
+}
+
+func (d *dashboardTest) reconcile() {
+	d.server.ResetCalls()
+
+	_, err := d.reconciler.Reconcile(ctrl.Request{NamespacedName: dashboardName})
+	assert.NilError(d.t, err)
+}
+
+func (d *dashboardTest) dashboard() *monitoringv1alpha1.Dashboard {
+	dashboard := &monitoringv1alpha1.Dashboard{}
+	assert.NilError(d.t, d.client.Get(context.Background(), dashboardName, dashboard))
+
+	return dashboard
+}
+
+func (d *dashboardTest) update(change func(dashboard *monitoringv1alpha1.Dashboard)) {
+	dashboard := d.dashboard()
+	change(dashboard)
+	assert.NilError(d.t, d.client.Update(context.Background(), dashboard))
+}
+
+func (d *dashboardTest) assertCondition(conditionType monitoringv1alpha1.ConditionType, status corev1.ConditionStatus, reason string) {
+	for _, condition := range d.dashboard().Status.Conditions {
+		if condition.Type == conditionType {
+			assert.Equal(d.t, condition.Status, status)
+			assert.Equal(d.t, condition.Reason, reason)
+
+			return
+		}
+	}
+
+	d.t.Fatalf("missing condition %s", conditionType)
+}
+
+func TestDashboardReconciler(t *testing.T) {
+	d := newDashboardTest(t, newTestDashboard())
+	defer d.server.Close()
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"POST /api/v1/dashboard"})
+	assert.Equal(t, d.dashboard().Status.DashboardID, "abc-def-001")
+	assert.DeepEqual(t, d.dashboard().Finalizers, []string{dashboardFinalizerName})
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/dashboard/abc-def-001"})
+
+	d.update(func(dashboard *monitoringv1alpha1.Dashboard) {
+		dashboard.Spec.Title = "Service overview"
+	})
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/dashboard/abc-def-001", "PUT /api/v1/dashboard/abc-def-001"})
+	board, _ := d.server.Board("abc-def-001")
+	assert.Equal(t, board.GetTitle(), "Service overview")
+
+	now := metav1.Now()
+	d.update(func(dashboard *monitoringv1alpha1.Dashboard) {
+		dashboard.DeletionTimestamp = &now
+	})
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"DELETE /api/v1/dashboard/abc-def-001"})
+	assert.Equal(t, len(d.dashboard().Finalizers), 0)
+
+	_, ok := d.server.Board("abc-def-001")
+	assert.Assert(t, !ok)
+}
+
+func TestDashboardReconcilerRecreate(t *testing.T) {
+	d := newDashboardTest(t, newTestDashboard())
+	defer d.server.Close()
+
+	d.reconcile()
+	d.server.RemoveBoard("abc-def-001")
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/dashboard/abc-def-001", "POST /api/v1/dashboard"})
+	assert.Equal(t, d.dashboard().Status.DashboardID, "abc-def-003")
+}
+
+func TestDashboardReconcilerBadRequest(t *testing.T) {
+	dashboard := newTestDashboard()
+	dashboard.Spec.Title = " "
+
+	d := newDashboardTest(t, dashboard)
+	defer d.server.Close()
+
+	d.reconcile()
+
+	assert.Equal(t, lastEvent(d.recorder), "Warning BadRequest DataDog API rejected the dashboard: The value provided for parameter 'title' is invalid")
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonBadRequest)
+	d.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionTrue, reasonBadRequest)
+
+	d.update(func(dashboard *monitoringv1alpha1.Dashboard) {
+		dashboard.Spec.Title = "Overview"
+	})
+
+	d.reconcile()
+
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+	d.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionFalse, reasonSynced)
+}
+
+func TestDashboardReconcilerDryRunDelete(t *testing.T) {
+	d := newDashboardTest(t, newTestDashboard())
+	defer d.server.Close()
+
+	d.reconcile()
+
+	now := metav1.Now()
+	d.update(func(dashboard *monitoringv1alpha1.Dashboard) {
+		dashboard.DeletionTimestamp = &now
+	})
+
+	d.reconciler.DryRun = true
+	d.reconcile()
+
+	assert.Equal(t, len(serverCalls(d.server)), 0)
+	assert.DeepEqual(t, d.dashboard().Finalizers, []string{dashboardFinalizerName})
+	assert.Equal(t, lastEvent(d.recorder), "Normal DryRun Would delete dashboard abc-def-001")
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonDryRun)
+
+	d.reconcile()
+
+	assert.Equal(t, lastEvent(d.recorder), "")
+
+	d.reconciler.DryRun = false
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"DELETE /api/v1/dashboard/abc-def-001"})
+	assert.Equal(t, len(d.dashboard().Finalizers), 0)
+}
@@ -0,0 +1,403 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	downtimeFinalizerName = "monitoring.datadog.com.downtime"
+
+	// unresolvedMonitorRequeueDelay is how long to wait before retrying when a
+	// referenced Monitor does not exist or has not been created in DataDog yet.
+	unresolvedMonitorRequeueDelay = 30 * time.Second
+)
+
+// DowntimeReconciler reconciles a Downtime object
+type DowntimeReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	DataDogClient *datadog.Client
+	// DryRun logs what would be changed in DataDog without changing it.
+	DryRun bool
+}
+
+// resolveMonitorIDs returns the DataDog monitor IDs of the Monitor objects
+// referenced by the downtime. A downtime without references resolves to a
+// single 0 ID, which schedules a downtime for the whole scope.
+func (r *DowntimeReconciler) resolveMonitorIDs(downtime *monitoringv1alpha1.Downtime) ([]int, bool, error) {
+	spec := downtime.Spec
+
+	if len(spec.Monitors) == 0 && spec.MonitorSelector == nil {
+		return []int{0}, true, nil
+	}
+
+	return resolveMonitors(r, downtime.Namespace, spec.Monitors, spec.MonitorSelector)
+}
+
+func (r *DowntimeReconciler) scheduleDowntime(req ctrl.Request, downtime *monitoringv1alpha1.Downtime, scheduled *monitoringv1alpha1.ScheduledDowntime) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"downtime",
+		req.NamespacedName,
+		"monitor_id",
+		scheduled.MonitorID,
+	)
+
+	if scheduled.DowntimeID != 0 {
+		ddDowntime, err := client.GetDowntime(scheduled.DowntimeID)
+		if err == nil {
+			changed, err := datadog.ChangeDowntime(ddDowntime, downtime, scheduled.MonitorID)
+			if err != nil {
+				return err
+			}
+
+			if !changed {
+				log.Info("Skipping update of unchanged downtime", "downtime_id", scheduled.DowntimeID)
+
+				return nil
+			}
+
+			if r.DryRun {
+				log.Info("Dry run, would update downtime", "downtime_id", scheduled.DowntimeID)
+
+				return nil
+			}
+
+			err = client.UpdateDowntime(ddDowntime)
+			if err != nil {
+				return err
+			}
+
+			log.Info("Successfully updated downtime", "downtime_id", scheduled.DowntimeID)
+
+			return nil
+		}
+
+		if !datadog.IsNotFound(err) {
+			return err
+		}
+
+		log.Info("Existing downtime not found, creating again", "downtime_id", scheduled.DowntimeID)
+	}
+
+	log.Info("Creating downtime")
+
+	ddDowntime := &datadog.Downtime{}
+	_, err := datadog.ChangeDowntime(ddDowntime, downtime, scheduled.MonitorID)
+	if err != nil {
+		return err
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would create downtime")
+
+		return nil
+	}
+
+	newDDDowntime, err := client.CreateDowntime(ddDowntime)
+	if err != nil {
+		return err
+	}
+
+	scheduled.DowntimeID = *newDDDowntime.Id
+
+	log.Info("Successfully created downtime", "downtime_id", scheduled.DowntimeID)
+
+	return nil
+}
+
+func (r *DowntimeReconciler) cancelDowntime(req ctrl.Request, scheduled monitoringv1alpha1.ScheduledDowntime) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"downtime",
+		req.NamespacedName,
+		"monitor_id",
+		scheduled.MonitorID,
+		"downtime_id",
+		scheduled.DowntimeID,
+	)
+
+	if r.DryRun {
+		log.Info("Dry run, would cancel downtime")
+
+		return nil
+	}
+
+	log.Info("Cancelling downtime")
+
+	err := client.DeleteDowntime(scheduled.DowntimeID)
+	if err != nil {
+		return datadog.IgnoreNotFound(err)
+	}
+
+	log.Info("Successfully cancelled downtime")
+
+	return nil
+}
+
+func (r *DowntimeReconciler) syncDowntime(req ctrl.Request, downtime *monitoringv1alpha1.Downtime) (bool, error) {
+	monitorIDs, resolved, err := r.resolveMonitorIDs(downtime)
+	if err != nil {
+		return false, err
+	}
+
+	existing := map[int]monitoringv1alpha1.ScheduledDowntime{}
+	for _, scheduled := range downtime.Status.Downtimes {
+		existing[scheduled.MonitorID] = scheduled
+	}
+
+	var syncErr error
+	downtimes := []monitoringv1alpha1.ScheduledDowntime{}
+
+	for _, monitorID := range monitorIDs {
+		scheduled := existing[monitorID]
+		scheduled.MonitorID = monitorID
+
+		syncErr = r.scheduleDowntime(req, downtime, &scheduled)
+		if syncErr != nil {
+			break
+		}
+
+		delete(existing, monitorID)
+		downtimes = append(downtimes, scheduled)
+	}
+
+	if syncErr == nil {
+		for monitorID, scheduled := range existing {
+			syncErr = r.cancelDowntime(req, scheduled)
+			if syncErr != nil {
+				break
+			}
+
+			delete(existing, monitorID)
+		}
+	}
+
+	// A dry run only logs the changes, the downtimes tracked in the status are
+	// left as they are.
+	if r.DryRun {
+		return resolved, syncErr
+	}
+
+	// Anything left over was not cancelled, keep tracking it so a later
+	// reconcile can retry.
+	for _, scheduled := range existing {
+		downtimes = append(downtimes, scheduled)
+	}
+
+	sort.Slice(downtimes, func(i, j int) bool {
+		return downtimes[i].MonitorID < downtimes[j].MonitorID
+	})
+
+	downtime.Status.Downtimes = downtimes
+
+	err = r.Status().Update(context.Background(), downtime)
+	if err != nil {
+		return false, err
+	}
+
+	if syncErr != nil {
+		return false, syncErr
+	}
+
+	if !hasFinalizer(&downtime.ObjectMeta, downtimeFinalizerName) {
+		addFinalizer(&downtime.ObjectMeta, downtimeFinalizerName)
+
+		err = r.Update(context.Background(), downtime)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return resolved, nil
+}
+
+func (r *DowntimeReconciler) deleteDowntime(req ctrl.Request, downtime *monitoringv1alpha1.Downtime) error {
+	log := r.Log.WithValues("downtime", req.NamespacedName)
+
+	if r.DryRun {
+		return r.planDeletion(req, downtime)
+	}
+
+	log.Info("Deleting downtime")
+
+	for _, scheduled := range downtime.Status.Downtimes {
+		err := r.cancelDowntime(req, scheduled)
+		if err != nil {
+			return err
+		}
+	}
+
+	removeFinalizer(&downtime.ObjectMeta, downtimeFinalizerName)
+
+	err := r.Update(context.Background(), downtime)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully deleted downtime")
+
+	return nil
+}
+
+// planDeletion records the downtimes that would be cancelled. The finalizer
+// stays until the operator runs without --dry-run and cancels them.
+func (r *DowntimeReconciler) planDeletion(req ctrl.Request, downtime *monitoringv1alpha1.Downtime) error {
+	ids := []string{}
+	for _, scheduled := range downtime.Status.Downtimes {
+		ids = append(ids, strconv.Itoa(scheduled.DowntimeID))
+	}
+
+	message := fmt.Sprintf("Would cancel downtimes %s", strings.Join(ids, ", "))
+
+	r.Log.Info("Dry run", "downtime", req.NamespacedName, "message", message)
+
+	if !setResourcePlanned(&downtime.Status.Conditions, message) {
+		return nil
+	}
+
+	r.Recorder.Event(downtime, corev1.EventTypeNormal, reasonDryRun, message)
+
+	return r.Status().Update(context.Background(), downtime)
+}
+
+func (r *DowntimeReconciler) handleError(req ctrl.Request, downtime *monitoringv1alpha1.Downtime, err error) (ctrl.Result, error) {
+	log := r.Log.WithValues("downtime", req.NamespacedName)
+
+	if datadog.IsBadRequest(err) {
+		log.Error(err, "Bad request to DataDog API", "reason", datadog.ErrorReason(err))
+
+		r.Recorder.Eventf(downtime, corev1.EventTypeWarning, reasonBadRequest, "DataDog API rejected the downtime: %s", datadog.ErrorReason(err))
+
+		r.setError(req, downtime, reasonBadRequest, err)
+
+		return ctrl.Result{}, nil
+	} else if datadog.IsForbidden(err) {
+		log.Error(nil, "Failed to authenticate with DataDog API")
+
+		r.Recorder.Event(downtime, corev1.EventTypeWarning, reasonForbidden, "Failed to authenticate with DataDog API")
+
+		r.setError(req, downtime, reasonForbidden, err)
+
+		return ctrl.Result{}, nil
+	} else {
+		return ctrl.Result{}, err
+	}
+}
+
+// setError records a failed sync with DataDog in the downtime status.
+func (r *DowntimeReconciler) setError(req ctrl.Request, downtime *monitoringv1alpha1.Downtime, reason string, err error) {
+	setResourceError(&downtime.Status.Conditions, reason, err)
+
+	updateErr := r.Status().Update(context.Background(), downtime)
+	if updateErr != nil {
+		r.Log.Error(updateErr, "Failed to update downtime status", "downtime", req.NamespacedName)
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=downtimes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=downtimes/status,verbs=get;update;patch
+
+func (r *DowntimeReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	downtime := &monitoringv1alpha1.Downtime{}
+	err := r.Get(ctx, req.NamespacedName, downtime)
+	if err != nil {
+		return ctrl.Result{}, ignoreNotFound(err)
+	}
+
+	if isBeingDeleted(&downtime.ObjectMeta, downtimeFinalizerName) {
+		err := r.deleteDowntime(req, downtime)
+		if err != nil {
+			return r.handleError(req, downtime, err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	resolved, err := r.syncDowntime(req, downtime)
+	if err != nil {
+		return r.handleError(req, downtime, err)
+	}
+
+	if !resolved {
+		r.Log.Info("Waiting for referenced monitors to be created", "downtime", req.NamespacedName)
+
+		return ctrl.Result{RequeueAfter: unresolvedMonitorRequeueDelay}, nil
+	}
+
+	if !r.DryRun && setResourceSynced(&downtime.Status.Conditions) {
+		err = r.Status().Update(ctx, downtime)
+	}
+
+	return ctrl.Result{}, err
+}
+
+// monitorToDowntimes maps a Monitor to the Downtimes in its namespace naming
+// or selecting it, so they pick up a new monitor ID or a change of labels.
+func (r *DowntimeReconciler) monitorToDowntimes(obj handler.MapObject) []reconcile.Request {
+	downtimes := &monitoringv1alpha1.DowntimeList{}
+	err := r.List(context.Background(), downtimes, client.InNamespace(obj.Meta.GetNamespace()))
+	if err != nil {
+		r.Log.Error(err, "Failed to list downtimes", "monitor", obj.Meta.GetName())
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, downtime := range downtimes.Items {
+		if referencesMonitor(obj.Meta, downtime.Spec.Monitors, downtime.Spec.MonitorSelector) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: downtime.Namespace, Name: downtime.Name},
+			})
+		}
+	}
+
+	return requests
+}
+
+func (r *DowntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&monitoringv1alpha1.Downtime{}).
+		Watches(
+			&source.Kind{Type: &monitoringv1beta1.Monitor{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.monitorToDowntimes)},
+		).
+		Complete(r)
+}
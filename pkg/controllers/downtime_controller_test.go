@@ -0,0 +1,289 @@
+package controllers
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+var downtimeName = types.NamespacedName{Namespace: "default", Name: "maintenance"}
+
+func newTestDowntime(monitors ...string) *monitoringv1alpha1.Downtime {
+	return &monitoringv1alpha1.Downtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: downtimeName.Namespace,
+			Name:      downtimeName.Name,
+		},
+		Spec: monitoringv1alpha1.DowntimeSpec{
+			Scope:    []string{"env:prod"},
+			Message:  "Maintenance",
+			Monitors: monitors,
+		},
+	}
+}
+
+// newSyncedMonitor returns a Monitor already created in DataDog with the given ID.
+func newSyncedMonitor(name string, id int) *monitoringv1beta1.Monitor {
+	monitor := newTestMonitor()
+	monitor.Name = name
+	monitor.Status.MonitorID = id
+
+	return monitor
+}
+
+type downtimeTest struct {
+	t          *testing.T
+	server     *ddfake.Server
+	client     client.Client
+	recorder   *record.FakeRecorder
+	reconciler *DowntimeReconciler
+}
+
+func newDowntimeTest(t *testing.T, objs ...runtime.Object) *downtimeTest {
+	server := ddfake.NewServer()
+	c := fake.NewFakeClientWithScheme(newTestScheme(t), objs...)
+	recorder := record.NewFakeRecorder(100)
+
+	return &downtimeTest{
+		t:        t,
+		server:   server,
+		client:   c,
+		recorder: recorder,
+		reconciler: &DowntimeReconciler{
+			Client:        c,
+			Log:           ctrl.Log.WithName("test"),
+			Recorder:      recorder,
+			DataDogClient: server.Client(),
+		},
+	}
+}
+
+func (d *downtimeTest) reconcile() ctrl.Result {
+	d.server.ResetCalls()
+
+	result, err := d.reconciler.Reconcile(ctrl.Request{NamespacedName: downtimeName})
+	assert.NilError(d.t, err)
+
+	return result
+}
+
+func (d *downtimeTest) downtime() *monitoringv1alpha1.Downtime {
+	downtime := &monitoringv1alpha1.Downtime{}
+	assert.NilError(d.t, d.client.Get(context.Background(), downtimeName, downtime))
+
+	return downtime
+}
+
+func (d *downtimeTest) update(change func(downtime *monitoringv1alpha1.Downtime)) {
+	downtime := d.downtime()
+	change(downtime)
+	assert.NilError(d.t, d.client.Update(context.Background(), downtime))
+}
+
+func (d *downtimeTest) assertCondition(conditionType monitoringv1alpha1.ConditionType, status corev1.ConditionStatus, reason string) {
+	for _, condition := range d.downtime().Status.Conditions {
+		if condition.Type == conditionType {
+			assert.Equal(d.t, condition.Status, status)
+			assert.Equal(d.t, condition.Reason, reason)
+
+			return
+		}
+	}
+
+	d.t.Fatalf("missing condition %s", conditionType)
+}
+
+func TestDowntimeReconciler(t *testing.T) {
+	d := newDowntimeTest(t, newTestDowntime())
+	defer d.server.Close()
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"POST /api/v1/downtime"})
+	assert.DeepEqual(t, d.downtime().Status.Downtimes, []monitoringv1alpha1.ScheduledDowntime{{DowntimeID: 1}})
+	assert.DeepEqual(t, d.downtime().Finalizers, []string{downtimeFinalizerName})
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/downtime/1"})
+
+	d.update(func(downtime *monitoringv1alpha1.Downtime) {
+		downtime.Spec.Message = "Upgrade"
+	})
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/downtime/1", "PUT /api/v1/downtime/1"})
+	ddDowntime, _ := d.server.Downtime(1)
+	assert.Equal(t, ddDowntime.GetMessage(), "Upgrade")
+
+	now := metav1.Now()
+	d.update(func(downtime *monitoringv1alpha1.Downtime) {
+		downtime.DeletionTimestamp = &now
+	})
+
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"DELETE /api/v1/downtime/1"})
+	assert.Equal(t, len(d.downtime().Finalizers), 0)
+
+	_, ok := d.server.Downtime(1)
+	assert.Assert(t, !ok)
+}
+
+func TestDowntimeReconcilerMonitors(t *testing.T) {
+	d := newDowntimeTest(t,
+		newTestDowntime("high-cpu", "low-disk", "pending"),
+		newSyncedMonitor("high-cpu", 10),
+		newSyncedMonitor("low-disk", 20),
+	)
+	defer d.server.Close()
+
+	result := d.reconcile()
+
+	assert.Equal(t, result.RequeueAfter, unresolvedMonitorRequeueDelay)
+	assert.DeepEqual(t, d.downtime().Status.Downtimes, []monitoringv1alpha1.ScheduledDowntime{
+		{MonitorID: 10, DowntimeID: 1},
+		{MonitorID: 20, DowntimeID: 2},
+	})
+
+	ddDowntime, _ := d.server.Downtime(2)
+	assert.Equal(t, ddDowntime.GetMonitorId(), 20)
+
+	d.update(func(downtime *monitoringv1alpha1.Downtime) {
+		downtime.Spec.Monitors = []string{"high-cpu"}
+	})
+
+	result = d.reconcile()
+
+	assert.Equal(t, result.RequeueAfter, time.Duration(0))
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/downtime/1", "DELETE /api/v1/downtime/2"})
+	assert.DeepEqual(t, d.downtime().Status.Downtimes, []monitoringv1alpha1.ScheduledDowntime{{MonitorID: 10, DowntimeID: 1}})
+}
+
+func TestDowntimeReconcilerBadRequest(t *testing.T) {
+	downtime := newTestDowntime()
+	downtime.Spec.Scope = nil
+
+	d := newDowntimeTest(t, downtime)
+	defer d.server.Close()
+
+	d.reconcile()
+
+	assert.Equal(t, lastEvent(d.recorder), "Warning BadRequest DataDog API rejected the downtime: The value provided for parameter 'scope' is invalid")
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonBadRequest)
+	d.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionTrue, reasonBadRequest)
+}
+
+func TestMonitorToDowntimes(t *testing.T) {
+	scheme := newTestScheme(t)
+
+	newDowntime := func(namespace, name string, monitors []string, selector *metav1.LabelSelector) *monitoringv1alpha1.Downtime {
+		return &monitoringv1alpha1.Downtime{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: monitoringv1alpha1.DowntimeSpec{
+				Scope:           []string{"*"},
+				Monitors:        monitors,
+				MonitorSelector: selector,
+			},
+		}
+	}
+
+	reconciler := &DowntimeReconciler{
+		Client: fake.NewFakeClientWithScheme(scheme,
+			newDowntime("default", "by-name", []string{"high-cpu"}, nil),
+			newDowntime("default", "by-label", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}),
+			newDowntime("default", "other", []string{"low-disk"}, nil),
+			newDowntime("default", "scope", nil, nil),
+			newDowntime("other", "by-name", []string{"high-cpu"}, nil),
+		),
+		Log: ctrl.Log.WithName("test"),
+	}
+
+	tests := []struct {
+		labels   map[string]string
+		expected []string
+	}{
+		{nil, []string{"by-name"}},
+		{map[string]string{"team": "a"}, []string{"by-label", "by-name"}},
+		{map[string]string{"team": "b"}, []string{"by-name"}},
+	}
+
+	for _, test := range tests {
+		monitor := newTestMonitor()
+		monitor.Labels = test.labels
+
+		requests := reconciler.monitorToDowntimes(handler.MapObject{Meta: monitor, Object: monitor})
+		sort.Slice(requests, func(i, j int) bool { return requests[i].Name < requests[j].Name })
+
+		expected := []reconcile.Request{}
+		for _, name := range test.expected {
+			expected = append(expected, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: name}})
+		}
+
+		assert.DeepEqual(t, requests, expected)
+	}
+}
+
+func TestDowntimeReconcilerDryRun(t *testing.T) {
+	d := newDowntimeTest(t,
+		newTestDowntime("high-cpu", "low-disk"),
+		newSyncedMonitor("high-cpu", 10),
+		newSyncedMonitor("low-disk", 20),
+	)
+	defer d.server.Close()
+
+	d.reconcile()
+
+	scheduled := []monitoringv1alpha1.ScheduledDowntime{
+		{MonitorID: 10, DowntimeID: 1},
+		{MonitorID: 20, DowntimeID: 2},
+	}
+
+	d.update(func(downtime *monitoringv1alpha1.Downtime) {
+		downtime.Spec.Monitors = []string{"high-cpu", "pending"}
+	})
+
+	d.reconciler.DryRun = true
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"GET /api/v1/downtime/1"})
+	assert.DeepEqual(t, d.downtime().Status.Downtimes, scheduled)
+
+	now := metav1.Now()
+	d.update(func(downtime *monitoringv1alpha1.Downtime) {
+		downtime.DeletionTimestamp = &now
+	})
+
+	d.reconcile()
+
+	assert.Equal(t, len(serverCalls(d.server)), 0)
+	assert.DeepEqual(t, d.downtime().Status.Downtimes, scheduled)
+	assert.DeepEqual(t, d.downtime().Finalizers, []string{downtimeFinalizerName})
+	assert.Equal(t, lastEvent(d.recorder), "Normal DryRun Would cancel downtimes 1, 2")
+	d.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonDryRun)
+
+	d.reconciler.DryRun = false
+	d.reconcile()
+
+	assert.DeepEqual(t, serverCalls(d.server), []string{"DELETE /api/v1/downtime/1", "DELETE /api/v1/downtime/2"})
+	assert.Equal(t, len(d.downtime().Finalizers), 0)
+}
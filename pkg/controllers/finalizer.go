@@ -14,6 +14,10 @@ func hasFinalizer(meta *metav1.ObjectMeta, finalizer string) bool {
 	return false
 }
 
+func isBeingDeleted(meta *metav1.ObjectMeta, finalizer string) bool {
+	return !meta.DeletionTimestamp.IsZero() && hasFinalizer(meta, finalizer)
+}
+
 func addFinalizer(meta *metav1.ObjectMeta, finalizer string) {
 	if hasFinalizer(meta, finalizer) {
 		return
@@ -0,0 +1,115 @@
+package controllers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	syncStateSynced  = "synced"
+	syncStateDrifted = "drifted"
+	syncStateError   = "error"
+	syncStatePaused  = "paused"
+
+	operationCreate = "create"
+	operationAdopt  = "adopt"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+var syncStates = []string{syncStateSynced, syncStateDrifted, syncStateError, syncStatePaused}
+
+var (
+	monitorsBySyncState = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "datadog_monitors",
+			Help: "Monitors by the state of their last sync with DataDog",
+		},
+		[]string{"state"},
+	)
+
+	monitorDrifts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "datadog_monitor_drift_detected_total",
+			Help: "Monitors found changed in DataDog outside of their spec, by drift policy",
+		},
+		[]string{"policy"},
+	)
+
+	monitorOperations = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "datadog_monitor_operations_total",
+			Help: "Monitors created, adopted, updated and deleted in DataDog",
+		},
+		[]string{"operation"},
+	)
+
+	monitorLastSync = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "datadog_monitor_last_sync_timestamp_seconds",
+			Help: "Time of the last successful sync of any monitor with DataDog",
+		},
+	)
+
+	credentialsValid = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "datadog_credentials_valid",
+			Help: "Whether the last validation of the DataDog credentials succeeded",
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(monitorsBySyncState, monitorDrifts, monitorOperations, monitorLastSync, credentialsValid)
+
+	for _, state := range syncStates {
+		monitorsBySyncState.WithLabelValues(state)
+	}
+}
+
+// monitorStates tracks the sync state of each monitor for the
+// datadog_monitors gauge.
+var monitorStates = &syncStateTracker{states: map[types.NamespacedName]string{}}
+
+type syncStateTracker struct {
+	mu     sync.Mutex
+	states map[types.NamespacedName]string
+}
+
+// set records the state of a monitor, a successful sync also moves the last
+// sync timestamp.
+func (t *syncStateTracker) set(name types.NamespacedName, state string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.states[name] = state
+	t.update()
+
+	if state == syncStateSynced || state == syncStateDrifted {
+		monitorLastSync.Set(float64(time.Now().Unix()))
+	}
+}
+
+// forget stops counting a monitor that is gone.
+func (t *syncStateTracker) forget(name types.NamespacedName) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.states, name)
+	t.update()
+}
+
+func (t *syncStateTracker) update() {
+	counts := map[string]int{}
+	for _, state := range t.states {
+		counts[state]++
+	}
+
+	for _, state := range syncStates {
+		monitorsBySyncState.WithLabelValues(state).Set(float64(counts[state]))
+	}
+}
@@ -17,40 +17,122 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
-	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
 	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	"github.com/stefansedich/datadog-operator/pkg/tags"
 )
 
 const (
 	finalizerName = "monitoring.datadog.com.monitor"
+
+	resyncIntervalAnnotation = "monitoring.datadog.com/resync-interval"
+	adoptMonitorIDAnnotation = "monitoring.datadog.com/adopt-monitor-id"
+
+	// defaultRateLimitRetry is used when DataDog does not say when the rate
+	// limit resets.
+	defaultRateLimitRetry = 30 * time.Second
 )
 
 // MonitorReconciler reconciles a Monitor object
 type MonitorReconciler struct {
 	client.Client
 	Log           logr.Logger
-	DataDogClient *datadog.Client
+	Recorder      record.EventRecorder
+	DataDogClient datadog.MonitorAPI
+	// ResyncInterval requeues each monitor to check it for drift, zero
+	// disables it unless set on the monitor with the resync-interval annotation.
+	ResyncInterval time.Duration
+	// DefaultDeletionPolicy applies to monitors without a deletion policy.
+	DefaultDeletionPolicy monitoringv1beta1.DeletionPolicy
+	// DryRun records what would be changed in DataDog without changing it.
+	DryRun bool
+	// DefaultTags adds the default tags to monitors missing them, only set
+	// when the mutating webhook that otherwise adds them is disabled.
+	DefaultTags *tags.Defaulter
+
+	accounts accountClients
 }
 
-func isBeingCreated(monitor *monitoringv1alpha1.Monitor) bool {
+func isBeingCreated(monitor *monitoringv1beta1.Monitor) bool {
 	return monitor.Status.MonitorID == 0
 }
 
-func isBeingDeleted(monitor *monitoringv1alpha1.Monitor) bool {
-	return !monitor.ObjectMeta.DeletionTimestamp.IsZero() &&
-		hasFinalizer(&monitor.ObjectMeta, finalizerName)
+// adoptMonitorID returns the ID of an existing DataDog monitor to take over,
+// from the spec or else the adopt-monitor-id annotation.
+func adoptMonitorID(monitor *monitoringv1beta1.Monitor) (int, error) {
+	if monitor.Spec.AdoptMonitorID != 0 {
+		return monitor.Spec.AdoptMonitorID, nil
+	}
+
+	value, ok := monitor.Annotations[adoptMonitorIDAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q", adoptMonitorIDAnnotation, value)
+	}
+
+	return id, nil
+}
+
+// isDrifted tells a change made in DataDog apart from a change to the spec,
+// which bumps the generation.
+func isDrifted(monitor *monitoringv1beta1.Monitor) bool {
+	return monitor.Generation == monitor.Status.ObservedGeneration
+}
+
+func driftPolicy(monitor *monitoringv1beta1.Monitor) monitoringv1beta1.DriftPolicy {
+	if monitor.Spec.DriftPolicy == "" {
+		return monitoringv1beta1.DriftPolicyRevert
+	}
+
+	return monitor.Spec.DriftPolicy
+}
+
+func (r *MonitorReconciler) deletionPolicy(monitor *monitoringv1beta1.Monitor) monitoringv1beta1.DeletionPolicy {
+	if monitor.Spec.DeletionPolicy == "" {
+		return r.DefaultDeletionPolicy
+	}
+
+	return monitor.Spec.DeletionPolicy
+}
+
+func (r *MonitorReconciler) resyncInterval(monitor *monitoringv1beta1.Monitor) time.Duration {
+	value, ok := monitor.Annotations[resyncIntervalAnnotation]
+	if !ok {
+		return r.ResyncInterval
+	}
+
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		r.Log.Error(err, "Ignoring invalid resync interval", "monitor", monitor.Name, "namespace", monitor.Namespace)
+		return r.ResyncInterval
+	}
+
+	return interval
 }
 
-func (r *MonitorReconciler) createMonitor(req ctrl.Request, monitor *monitoringv1alpha1.Monitor) error {
-	client := r.DataDogClient
+func (r *MonitorReconciler) createMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor) error {
 	log := r.Log.WithValues("monitor", req.NamespacedName)
 
-	log.Info("Creating monitor")
+	log.Info("Creating monitor", "site", client.Site())
 
 	ddMonitor := &datadog.Monitor{}
 	_, err := datadog.ChangeMonitor(ddMonitor, monitor)
@@ -63,7 +145,11 @@ func (r *MonitorReconciler) createMonitor(req ctrl.Request, monitor *monitoringv
 		return err
 	}
 
+	monitorOperations.WithLabelValues(operationCreate).Inc()
+
 	monitor.Status.MonitorID = *newDDMonitor.Id
+	monitor.Status.Site = client.Site()
+	monitor.Status.MutedScopes = mutedScopes(newDDMonitor.GetOptions().Silenced)
 
 	err = r.Status().Update(context.Background(), monitor)
 	if err != nil {
@@ -79,11 +165,127 @@ func (r *MonitorReconciler) createMonitor(req ctrl.Request, monitor *monitoringv
 
 	log.Info("Successfully created monitor", "monitor_id", *newDDMonitor.Id)
 
+	r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonCreated, "Created DataDog monitor %d", *newDDMonitor.Id)
+
+	return nil
+}
+
+func (r *MonitorReconciler) adoptMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor, id int) error {
+	log := r.Log.WithValues("monitor", req.NamespacedName, "monitor_id", id)
+
+	log.Info("Adopting monitor")
+
+	ddMonitor, err := client.GetMonitor(id)
+	if err != nil {
+		return fmt.Errorf("failed to get DataDog monitor %d to adopt: %w", id, err)
+	}
+
+	monitor.Status.MonitorID = id
+	monitor.Status.Site = client.Site()
+	monitor.Status.MutedScopes = mutedScopes(ddMonitor.GetOptions().Silenced)
+
+	diff, err := datadog.ChangeMonitor(ddMonitor, monitor)
+	if err != nil {
+		return err
+	}
+
+	if len(diff) > 0 {
+		log.Info("Applying spec to adopted monitor", "diff", diff.String())
+
+		err = client.UpdateMonitor(ddMonitor)
+		if err != nil {
+			return err
+		}
+
+		monitor.Status.LastAppliedDiff = diff
+	}
+
+	monitorOperations.WithLabelValues(operationAdopt).Inc()
+
+	err = r.Status().Update(context.Background(), monitor)
+	if err != nil {
+		return err
+	}
+
+	addFinalizer(&monitor.ObjectMeta, finalizerName)
+
+	err = r.Update(context.Background(), monitor)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully adopted monitor")
+
+	r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonAdopted, "Adopted DataDog monitor %d", id)
+
 	return nil
 }
 
-func (r *MonitorReconciler) updateMonitor(req ctrl.Request, monitor *monitoringv1alpha1.Monitor) error {
-	client := r.DataDogClient
+// accountKey identifies the DataDog account a monitor is managed in, monitors
+// without an account reference share the operator credentials.
+func accountKey(monitor *monitoringv1beta1.Monitor) string {
+	if monitor.Spec.AccountRef == nil {
+		return ""
+	}
+
+	return monitor.Namespace + "/" + monitor.Spec.AccountRef.Name
+}
+
+// adoptedBy returns the other Monitor already managing the DataDog monitor
+// this one would adopt, empty when there is none.
+func (r *MonitorReconciler) adoptedBy(monitor *monitoringv1beta1.Monitor) (string, error) {
+	id, err := adoptMonitorID(monitor)
+	if err != nil || id == 0 {
+		// An invalid annotation is reported when adopting.
+		return "", nil
+	}
+
+	monitors := &monitoringv1beta1.MonitorList{}
+	err = r.List(context.Background(), monitors)
+	if err != nil {
+		return "", err
+	}
+
+	// The monitor is still being created, so it cannot match itself.
+	for _, other := range monitors.Items {
+		if other.Status.MonitorID == id && accountKey(&other) == accountKey(monitor) {
+			return other.Namespace + "/" + other.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+// adoptionConflict refuses to adopt a DataDog monitor another Monitor
+// manages, as the two would overwrite each other. The error requeues the
+// monitor so it adopts once the other Monitor lets go.
+func (r *MonitorReconciler) adoptionConflict(req ctrl.Request, monitor *monitoringv1beta1.Monitor, owner string) (ctrl.Result, error) {
+	id, _ := adoptMonitorID(monitor)
+	err := fmt.Errorf("DataDog monitor %d is already managed by Monitor %s", id, owner)
+
+	r.Log.Error(err, "Refusing to adopt monitor", "monitor", req.NamespacedName)
+
+	r.Recorder.Event(monitor, corev1.EventTypeWarning, reasonAdoptConflict, err.Error())
+
+	r.setError(req, monitor, reasonAdoptConflict, err)
+
+	return ctrl.Result{}, err
+}
+
+func (r *MonitorReconciler) createOrAdoptMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor) (string, error) {
+	id, err := adoptMonitorID(monitor)
+	if err != nil {
+		return "", err
+	}
+
+	if id != 0 {
+		return reasonAdopted, r.adoptMonitor(client, req, monitor, id)
+	}
+
+	return reasonCreated, r.createMonitor(client, req, monitor)
+}
+
+func (r *MonitorReconciler) updateMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor) (string, error) {
 	log := r.Log.WithValues(
 		"monitor",
 		req.NamespacedName,
@@ -98,35 +300,75 @@ func (r *MonitorReconciler) updateMonitor(req ctrl.Request, monitor *monitoringv
 		if datadog.IsNotFound(err) {
 			log.Info("Existing monitor not found, creating again")
 
-			return r.createMonitor(req, monitor)
+			r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonRecreated, "DataDog monitor %d not found, creating again", monitor.Status.MonitorID)
+
+			return reasonRecreated, r.createMonitor(client, req, monitor)
 		}
 
-		return err
+		return "", err
 	}
 
-	changed, err := datadog.ChangeMonitor(ddMonitor, monitor)
+	if monitor.Status.Site == "" {
+		monitor.Status.Site = client.Site()
+	}
+
+	monitor.Status.MutedScopes = mutedScopes(ddMonitor.GetOptions().Silenced)
+
+	diff, err := datadog.ChangeMonitor(ddMonitor, monitor)
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	if wasPaused(monitor) && len(diff) > 0 {
+		log.Info("Monitor differs from spec after being paused", "diff", diff.String())
+
+		r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonResumed, "Resumed reconciling, DataDog monitor %d differs from its spec: %s", monitor.Status.MonitorID, diff)
 	}
 
-	if !changed {
-		log.Info("Skipping update of unchanged monitor")
+	if len(diff) == 0 {
+		log.V(1).Info("Skipping update of unchanged monitor")
 
-		return nil
+		return reasonUnchanged, nil
+	}
+
+	reason := reasonUpdated
+	if isDrifted(monitor) {
+		monitorDrifts.WithLabelValues(string(driftPolicy(monitor))).Inc()
+
+		if driftPolicy(monitor) == monitoringv1beta1.DriftPolicyReport {
+			log.Info("Monitor drifted from spec, reporting only", "diff", diff.String())
+
+			r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonDrifted, "DataDog monitor %d was changed outside of its spec: %s", monitor.Status.MonitorID, diff)
+
+			return reasonDrifted, nil
+		}
+
+		log.Info("Monitor drifted from spec, reverting")
+
+		reason = reasonDriftReverted
 	}
 
 	err = client.UpdateMonitor(ddMonitor)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	log.Info("Successfully updated monitor")
+	monitorOperations.WithLabelValues(operationUpdate).Inc()
 
-	return nil
+	log.Info("Successfully updated monitor", "diff", diff.String())
+
+	monitor.Status.LastAppliedDiff = diff
+
+	if reason == reasonDriftReverted {
+		r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonDriftReverted, "Reverted changes made outside of the spec to DataDog monitor %d: %s", monitor.Status.MonitorID, diff)
+	} else {
+		r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonUpdated, "Updated DataDog monitor %d: %s", monitor.Status.MonitorID, diff)
+	}
+
+	return reason, nil
 }
 
-func (r *MonitorReconciler) deleteMonitor(req ctrl.Request, monitor *monitoringv1alpha1.Monitor) error {
-	client := r.DataDogClient
+func (r *MonitorReconciler) deleteMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor, policy monitoringv1beta1.DeletionPolicy) error {
 	log := r.Log.WithValues(
 		"monitor",
 		req.NamespacedName,
@@ -134,76 +376,319 @@ func (r *MonitorReconciler) deleteMonitor(req ctrl.Request, monitor *monitoringv
 		monitor.Status.MonitorID,
 	)
 
-	log.Info("Deleting monitor")
+	if policy == monitoringv1beta1.DeletionPolicyOrphan {
+		log.Info("Orphaning monitor")
+	} else {
+		log.Info("Deleting monitor")
 
-	err := client.DeleteMonitor(monitor.Status.MonitorID)
-	if err != nil {
-		return datadog.IgnoreNotFound(err)
+		err := client.DeleteMonitor(monitor.Status.MonitorID)
+		if err != nil {
+			return datadog.IgnoreNotFound(err)
+		}
+
+		monitorOperations.WithLabelValues(operationDelete).Inc()
 	}
 
 	removeFinalizer(&monitor.ObjectMeta, finalizerName)
 
-	err = r.Update(context.Background(), monitor)
+	err := r.Update(context.Background(), monitor)
 	if err != nil {
 		return err
 	}
 
-	log.Info("Successfully deleted monitor")
+	monitorStates.forget(req.NamespacedName)
+
+	if policy == monitoringv1beta1.DeletionPolicyOrphan {
+		log.Info("Successfully orphaned monitor")
+
+		r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonOrphaned, "Left DataDog monitor %d in place", monitor.Status.MonitorID)
+	} else {
+		log.Info("Successfully deleted monitor")
+
+		r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonDeleted, "Deleted DataDog monitor %d", monitor.Status.MonitorID)
+	}
 
 	return nil
 }
 
-func (r *MonitorReconciler) handleError(req ctrl.Request, err error) (ctrl.Result, error) {
+// finalizeMonitor deletes or orphans the DataDog monitor of a deleted
+// Monitor. The account is only needed to delete, and once it is gone the
+// monitor is left in place rather than blocking the deletion forever.
+func (r *MonitorReconciler) finalizeMonitor(req ctrl.Request, monitor *monitoringv1beta1.Monitor) (ctrl.Result, error) {
+	var client datadog.MonitorAPI
+
+	policy := r.deletionPolicy(monitor)
+	if policy != monitoringv1beta1.DeletionPolicyOrphan {
+		var err error
+		client, err = r.accounts.get(r.Client, monitor.Namespace, monitor.Spec.AccountRef, r.DataDogClient)
+		if isAccountNotFound(err) {
+			r.Log.WithValues("monitor", req.NamespacedName).Info("Account not found, leaving monitor in place", "reason", err.Error())
+
+			r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonAccountError, "Leaving DataDog monitor %d in place: %s", monitor.Status.MonitorID, err)
+
+			policy = monitoringv1beta1.DeletionPolicyOrphan
+		} else if err != nil {
+			return r.accountError(req, monitor, err)
+		}
+	}
+
+	err := r.deleteMonitor(client, req, monitor, policy)
+	if err != nil {
+		return r.handleError(req, monitor, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// defaultTags adds any missing default tags to the monitor spec, returning
+// true when the monitor was updated and will be reconciled again.
+func (r *MonitorReconciler) defaultTags(monitor *monitoringv1beta1.Monitor) (bool, error) {
+	if r.DefaultTags == nil || monitor.DeletionTimestamp != nil {
+		return false, nil
+	}
+
+	ctx := context.Background()
+
+	namespace := &corev1.Namespace{}
+	err := r.Get(ctx, types.NamespacedName{Name: monitor.Namespace}, namespace)
+	if err != nil {
+		return false, err
+	}
+
+	defaulted := r.DefaultTags.Default(monitor.Spec.Tags, namespace)
+	if len(defaulted) == len(monitor.Spec.Tags) {
+		return false, nil
+	}
+
+	monitor.Spec.Tags = defaulted
+
+	return true, r.Update(ctx, monitor)
+}
+
+// setSynced marks the monitor as in sync with DataDog and persists its status.
+func (r *MonitorReconciler) setSynced(monitor *monitoringv1beta1.Monitor, reason string) error {
+	status := &monitor.Status
+
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reason, "")
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionSynced, corev1.ConditionTrue, reason, "")
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionError, corev1.ConditionFalse, reason, "")
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionDrifted, corev1.ConditionFalse, reason, "")
+	clearPaused(status)
+	status.ObservedGeneration = monitor.Generation
+	status.PlannedAction = ""
+
+	return r.updateSyncedStatus(monitor)
+}
+
+// setDrifted reports a monitor that was changed in DataDog and left as is
+// because of its drift policy.
+func (r *MonitorReconciler) setDrifted(monitor *monitoringv1beta1.Monitor) error {
+	status := &monitor.Status
+	message := "Monitor was changed in DataDog outside of its spec"
+
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionReady, corev1.ConditionFalse, reasonDrifted, message)
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionSynced, corev1.ConditionTrue, reasonDrifted, "")
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionError, corev1.ConditionFalse, reasonDrifted, "")
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionDrifted, corev1.ConditionTrue, reasonDrifted, message)
+	clearPaused(status)
+
+	return r.updateSyncedStatus(monitor)
+}
+
+// updateSyncedStatus persists the status of a successful sync, status only
+// updates are filtered out by monitorChanged so this does not reconcile again.
+func (r *MonitorReconciler) updateSyncedStatus(monitor *monitoringv1beta1.Monitor) error {
+	now := metav1.Now()
+	monitor.Status.LastSyncedTime = &now
+
+	return r.Status().Update(context.Background(), monitor)
+}
+
+// setError records a failed sync with DataDog in the monitor status.
+func (r *MonitorReconciler) setError(req ctrl.Request, monitor *monitoringv1beta1.Monitor, reason string, err error) {
+	log := r.Log.WithValues("monitor", req.NamespacedName)
+	status := &monitor.Status
+	message := datadog.ErrorReason(err)
+
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionReady, corev1.ConditionFalse, reason, message)
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionSynced, corev1.ConditionFalse, reason, message)
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionError, corev1.ConditionTrue, reason, message)
+
+	monitorStates.set(req.NamespacedName, syncStateError)
+
+	updateErr := r.Status().Update(context.Background(), monitor)
+	if updateErr != nil {
+		log.Error(updateErr, "Failed to update monitor status")
+	}
+}
+
+func (r *MonitorReconciler) accountError(req ctrl.Request, monitor *monitoringv1beta1.Monitor, err error) (ctrl.Result, error) {
+	r.Recorder.Event(monitor, corev1.EventTypeWarning, reasonAccountError, err.Error())
+
+	r.setError(req, monitor, reasonAccountError, err)
+
+	return ctrl.Result{}, err
+}
+
+func (r *MonitorReconciler) handleError(req ctrl.Request, monitor *monitoringv1beta1.Monitor, err error) (ctrl.Result, error) {
 	log := r.Log.WithValues("monitor", req.NamespacedName)
 
 	if datadog.IsBadRequest(err) {
-		// TODO: Extract reason from error
-		log.Error(err, "Bad request to DataDog API")
+		log.Error(err, "Bad request to DataDog API", "reason", datadog.ErrorReason(err))
+
+		r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonBadRequest, "DataDog API rejected the monitor: %s", datadog.ErrorReason(err))
+
+		r.setError(req, monitor, reasonBadRequest, err)
 
 		return ctrl.Result{}, nil
 	} else if datadog.IsForbidden(err) {
 		log.Error(nil, "Failed to authenticate with DataDog API")
 
+		r.Recorder.Event(monitor, corev1.EventTypeWarning, reasonForbidden, "Failed to authenticate with DataDog API")
+
+		r.setError(req, monitor, reasonForbidden, err)
+
 		return ctrl.Result{}, nil
+	} else if datadog.IsTooManyRequests(err) {
+		retryAfter := datadog.RetryAfter(err)
+		if retryAfter <= 0 {
+			retryAfter = defaultRateLimitRetry
+		}
+
+		log.Info("DataDog API rate limit reached", "retryAfter", retryAfter)
+
+		r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonRateLimited, "DataDog API rate limit reached, retrying in %s", retryAfter)
+
+		r.setError(req, monitor, reasonRateLimited, err)
+
+		return ctrl.Result{RequeueAfter: retryAfter}, nil
 	} else {
+		r.Recorder.Eventf(monitor, corev1.EventTypeWarning, reasonAPIError, "DataDog API request failed: %s", err)
+
+		r.setError(req, monitor, reasonAPIError, err)
+
 		return ctrl.Result{}, err
 	}
 }
 
 // +kubebuilder:rbac:groups=monitoring.datadog.com,resources=monitors,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.datadog.com,resources=monitors/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 func (r *MonitorReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	ctx := context.Background()
 
-	monitor := &monitoringv1alpha1.Monitor{}
+	monitor := &monitoringv1beta1.Monitor{}
 	err := r.Get(ctx, req.NamespacedName, monitor)
 	if err != nil {
+		if apierrs.IsNotFound(err) {
+			monitorStates.forget(req.NamespacedName)
+		}
+
 		return ctrl.Result{}, ignoreNotFound(err)
 	}
 
-	if isBeingDeleted(monitor) {
-		err := r.deleteMonitor(req, monitor)
-		if err != nil {
-			return r.handleError(req, err)
+	defaulted, err := r.defaultTags(monitor)
+	if err != nil || defaulted {
+		return ctrl.Result{Requeue: defaulted}, err
+	}
+
+	if isBeingDeleted(&monitor.ObjectMeta, finalizerName) {
+		if r.DryRun {
+			return r.planDeletion(req, monitor)
 		}
-	} else if isBeingCreated(monitor) {
-		err := r.createMonitor(req, monitor)
+
+		return r.finalizeMonitor(req, monitor)
+	}
+
+	if isPaused(monitor) {
+		return r.pauseMonitor(req, monitor)
+	}
+
+	client, err := r.accounts.get(r.Client, monitor.Namespace, monitor.Spec.AccountRef, r.DataDogClient)
+	if err != nil {
+		return r.accountError(req, monitor, err)
+	}
+
+	if isBeingCreated(monitor) {
+		owner, err := r.adoptedBy(monitor)
 		if err != nil {
-			return r.handleError(req, err)
+			return ctrl.Result{}, err
 		}
-	} else {
-		err := r.updateMonitor(req, monitor)
-		if err != nil {
-			return r.handleError(req, err)
+
+		if owner != "" {
+			return r.adoptionConflict(req, monitor, owner)
 		}
 	}
 
-	return ctrl.Result{}, nil
+	if r.DryRun {
+		return r.planMonitor(client, req, monitor)
+	}
+
+	var reason string
+	if isBeingCreated(monitor) {
+		reason, err = r.createOrAdoptMonitor(client, req, monitor)
+	} else {
+		reason, err = r.updateMonitor(client, req, monitor)
+	}
+
+	if err == nil {
+		err = r.muteMonitor(client, req, monitor)
+	}
+
+	if err != nil {
+		return r.handleError(req, monitor, err)
+	}
+
+	state := syncStateSynced
+	if reason == reasonDrifted {
+		state = syncStateDrifted
+		err = r.setDrifted(monitor)
+	} else {
+		err = r.setSynced(monitor, reason)
+	}
+
+	if err == nil {
+		monitorStates.set(req.NamespacedName, state)
+	}
+
+	requeueAfter := r.resyncInterval(monitor)
+	if end := muteEnd(monitor); end > 0 && (requeueAfter == 0 || end < requeueAfter) {
+		requeueAfter = end
+	}
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, err
+}
+
+// monitorChanged skips monitor updates that only touch the status. Spec
+// changes bump the generation, the annotations read by the reconciler and
+// the deletion timestamp are checked as they may not.
+var monitorChanged = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.MetaOld == nil || e.MetaNew == nil {
+			return true
+		}
+
+		if e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() ||
+			!e.MetaOld.GetDeletionTimestamp().Equal(e.MetaNew.GetDeletionTimestamp()) {
+			return true
+		}
+
+		for _, annotation := range []string{pausedAnnotation, resyncIntervalAnnotation, adoptMonitorIDAnnotation} {
+			if e.MetaOld.GetAnnotations()[annotation] != e.MetaNew.GetAnnotations()[annotation] {
+				return true
+			}
+		}
+
+		return false
+	},
 }
 
 func (r *MonitorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&monitoringv1alpha1.Monitor{}).
+		For(&monitoringv1beta1.Monitor{}).
+		WithEventFilter(monitorChanged).
 		Complete(r)
 }
@@ -0,0 +1,855 @@
+package controllers
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+	"github.com/stefansedich/datadog-operator/pkg/tags"
+)
+
+var monitorName = types.NamespacedName{Namespace: "default", Name: "high-cpu"}
+
+func newTestMonitor() *monitoringv1beta1.Monitor {
+	return &monitoringv1beta1.Monitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  monitorName.Namespace,
+			Name:       monitorName.Name,
+			Generation: 1,
+		},
+		Spec: monitoringv1beta1.MonitorSpec{
+			Type:    "metric alert",
+			Name:    "High CPU",
+			Message: "CPU is high",
+			Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+		},
+	}
+}
+
+// statusCountingClient counts the status updates made through it.
+type statusCountingClient struct {
+	client.Client
+	statusUpdates int
+}
+
+func (c *statusCountingClient) Status() client.StatusWriter {
+	return &countingStatusWriter{StatusWriter: c.Client.Status(), updates: &c.statusUpdates}
+}
+
+type countingStatusWriter struct {
+	client.StatusWriter
+	updates *int
+}
+
+func (w *countingStatusWriter) Update(ctx context.Context, obj runtime.Object, opts ...client.UpdateOption) error {
+	*w.updates++
+
+	return w.StatusWriter.Update(ctx, obj, opts...)
+}
+
+type monitorTest struct {
+	t          *testing.T
+	server     *ddfake.Server
+	client     client.Client
+	counter    *statusCountingClient
+	recorder   *record.FakeRecorder
+	reconciler *MonitorReconciler
+}
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+	assert.NilError(t, monitoringv1alpha1.AddToScheme(scheme))
+	assert.NilError(t, monitoringv1beta1.AddToScheme(scheme))
+
+	return scheme
+}
+
+// serverCalls returns the requests made to the fake DataDog API.
+func serverCalls(server *ddfake.Server) []string {
+	calls := []string{}
+	for _, call := range server.Calls() {
+		calls = append(calls, call.String())
+	}
+
+	return calls
+}
+
+// lastEvent returns the last event recorded since the previous call.
+func lastEvent(recorder *record.FakeRecorder) string {
+	var event string
+	for {
+		select {
+		case event = <-recorder.Events:
+		default:
+			return event
+		}
+	}
+}
+
+func newMonitorTest(t *testing.T, objs ...runtime.Object) *monitorTest {
+	server := ddfake.NewServer()
+	c := fake.NewFakeClientWithScheme(newTestScheme(t), objs...)
+	counter := &statusCountingClient{Client: c}
+	recorder := record.NewFakeRecorder(100)
+
+	return &monitorTest{
+		t:        t,
+		server:   server,
+		client:   c,
+		counter:  counter,
+		recorder: recorder,
+		reconciler: &MonitorReconciler{
+			Client:                counter,
+			Log:                   ctrl.Log.WithName("test"),
+			Recorder:              recorder,
+			DataDogClient:         server.Client(),
+			DefaultDeletionPolicy: monitoringv1beta1.DeletionPolicyDelete,
+		},
+	}
+}
+
+func (m *monitorTest) reconcile() (ctrl.Result, error) {
+	m.server.ResetCalls()
+	m.counter.statusUpdates = 0
+
+	return m.reconciler.Reconcile(ctrl.Request{NamespacedName: monitorName})
+}
+
+func (m *monitorTest) monitor() *monitoringv1beta1.Monitor {
+	monitor := &monitoringv1beta1.Monitor{}
+	assert.NilError(m.t, m.client.Get(context.Background(), monitorName, monitor))
+
+	return monitor
+}
+
+// update changes the monitor spec, bumping the generation as the API server would.
+func (m *monitorTest) update(change func(monitor *monitoringv1beta1.Monitor)) {
+	monitor := m.monitor()
+	change(monitor)
+	monitor.Generation++
+
+	assert.NilError(m.t, m.client.Update(context.Background(), monitor))
+}
+
+func (m *monitorTest) calls() []string {
+	return serverCalls(m.server)
+}
+
+func (m *monitorTest) lastEvent() string {
+	return lastEvent(m.recorder)
+}
+
+func (m *monitorTest) assertCondition(conditionType monitoringv1beta1.ConditionType, status corev1.ConditionStatus, reason string) {
+	condition := getCondition(m.monitor().Status.Conditions, conditionType)
+
+	assert.Assert(m.t, condition != nil, "missing condition %s", conditionType)
+	assert.Equal(m.t, condition.Status, status)
+	assert.Equal(m.t, condition.Reason, reason)
+}
+
+func TestMonitorReconcilerCreate(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"POST /api/v1/monitor"})
+	assert.Equal(t, m.lastEvent(), "Normal Created Created DataDog monitor 1")
+
+	monitor := m.monitor()
+	assert.Equal(t, monitor.Status.MonitorID, 1)
+	assert.DeepEqual(t, monitor.Finalizers, []string{finalizerName})
+	m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonCreated)
+
+	ddMonitor, ok := m.server.Monitor(1)
+	assert.Assert(t, ok)
+	assert.Equal(t, ddMonitor.GetQuery(), monitor.Spec.Query)
+}
+
+func TestMonitorReconcilerUpdate(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Equal(t, m.lastEvent(), "Normal Created Created DataDog monitor 1")
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+	assert.Equal(t, m.lastEvent(), "")
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.Query = "avg(last_5m):avg:system.cpu.user{*} > 95"
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "PUT /api/v1/monitor/1"})
+	m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonUpdated)
+	assert.DeepEqual(t, m.monitor().Status.LastAppliedDiff, []monitoringv1beta1.FieldDiff{{
+		Field: "query",
+		Old:   `"avg(last_5m):avg:system.cpu.user{*} > 90"`,
+		New:   `"avg(last_5m):avg:system.cpu.user{*} > 95"`,
+	}})
+
+	ddMonitor, _ := m.server.Monitor(1)
+	assert.Equal(t, ddMonitor.GetQuery(), "avg(last_5m):avg:system.cpu.user{*} > 95")
+}
+
+func TestMonitorReconcilerResyncUnchanged(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	synced := m.monitor()
+	synced.Status.LastSyncedTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+	assert.NilError(t, m.client.Status().Update(context.Background(), synced))
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	monitor := m.monitor()
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+	assert.Equal(t, m.counter.statusUpdates, 1)
+	assert.Assert(t, monitor.Status.LastSyncedTime.After(synced.Status.LastSyncedTime.Time))
+	assert.Equal(t, monitorChanged.Update(event.UpdateEvent{MetaOld: synced, ObjectOld: synced, MetaNew: monitor, ObjectNew: monitor}), false)
+}
+
+func TestMonitorChangedPredicate(t *testing.T) {
+	now := metav1.Now()
+
+	tests := []struct {
+		change func(monitor *monitoringv1beta1.Monitor)
+		want   bool
+	}{
+		{func(monitor *monitoringv1beta1.Monitor) { monitor.Status.MonitorID = 1 }, false},
+		{func(monitor *monitoringv1beta1.Monitor) { monitor.Generation++ }, true},
+		{func(monitor *monitoringv1beta1.Monitor) { monitor.DeletionTimestamp = &now }, true},
+		{func(monitor *monitoringv1beta1.Monitor) {
+			monitor.Annotations = map[string]string{pausedAnnotation: "true"}
+		}, true},
+		{func(monitor *monitoringv1beta1.Monitor) {
+			monitor.Annotations = map[string]string{resyncIntervalAnnotation: "5m"}
+		}, true},
+		{func(monitor *monitoringv1beta1.Monitor) {
+			monitor.Annotations = map[string]string{"example.com/other": "value"}
+		}, false},
+	}
+
+	for _, test := range tests {
+		old := newTestMonitor()
+		updated := old.DeepCopy()
+		test.change(updated)
+
+		got := monitorChanged.Update(event.UpdateEvent{MetaOld: old, ObjectOld: old, MetaNew: updated, ObjectNew: updated})
+
+		assert.Equal(t, got, test.want)
+	}
+}
+
+func TestMonitorReconcilerDefaultTags(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.Tags = []string{"service:api"}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: monitorName.Namespace, Labels: map[string]string{"team": "payments"}},
+	}
+
+	m := newMonitorTest(t, monitor, namespace)
+	defer m.server.Close()
+
+	m.reconciler.DefaultTags = &tags.Defaulter{ClusterName: "prod", NamespaceLabels: map[string]string{"team": "team"}}
+
+	result, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Assert(t, result.Requeue)
+
+	assert.DeepEqual(t, m.calls(), []string{})
+	assert.DeepEqual(t, m.monitor().Spec.Tags, []string{"service:api", "kube_cluster:prod", "kube_namespace:default", "team:payments"})
+
+	result, err = m.reconcile()
+	assert.NilError(t, err)
+	assert.Assert(t, !result.Requeue)
+
+	assert.DeepEqual(t, m.calls(), []string{"POST /api/v1/monitor"})
+
+	ddMonitor, _ := m.server.Monitor(1)
+	assert.DeepEqual(t, ddMonitor.Tags, []string{"service:api", "kube_cluster:prod", "kube_namespace:default", "team:payments"})
+}
+
+func TestMonitorReconcilerRevertsDrift(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	ddMonitor, _ := m.server.Monitor(1)
+	ddMonitor.SetName("Changed in DataDog")
+	m.server.SetMonitor(ddMonitor)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "PUT /api/v1/monitor/1"})
+	m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonDriftReverted)
+
+	ddMonitor, _ = m.server.Monitor(1)
+	assert.Equal(t, ddMonitor.GetName(), "High CPU")
+}
+
+func TestMonitorReconcilerRecreate(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	m.server.RemoveMonitor(1)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "POST /api/v1/monitor"})
+	assert.Equal(t, m.monitor().Status.MonitorID, 2)
+	m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonRecreated)
+}
+
+func TestMonitorReconcilerDelete(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	now := metav1.Now()
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.DeletionTimestamp = &now
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"DELETE /api/v1/monitor/1"})
+	assert.Equal(t, m.lastEvent(), "Normal Deleted Deleted DataDog monitor 1")
+	assert.Equal(t, len(m.monitor().Finalizers), 0)
+
+	_, ok := m.server.Monitor(1)
+	assert.Assert(t, !ok)
+}
+
+func TestMonitorReconcilerDryRunDelete(t *testing.T) {
+	for _, policy := range []monitoringv1beta1.DeletionPolicy{monitoringv1beta1.DeletionPolicyDelete, monitoringv1beta1.DeletionPolicyOrphan} {
+		t.Run(string(policy), func(t *testing.T) {
+			monitor := newTestMonitor()
+			monitor.Spec.DeletionPolicy = policy
+
+			m := newMonitorTest(t, monitor)
+			defer m.server.Close()
+
+			_, err := m.reconcile()
+			assert.NilError(t, err)
+
+			now := metav1.Now()
+			m.update(func(monitor *monitoringv1beta1.Monitor) {
+				monitor.DeletionTimestamp = &now
+			})
+
+			m.reconciler.DryRun = true
+
+			_, err = m.reconcile()
+			assert.NilError(t, err)
+
+			assert.DeepEqual(t, m.calls(), []string{})
+			assert.DeepEqual(t, m.monitor().Finalizers, []string{finalizerName})
+			m.assertCondition(monitoringv1beta1.ConditionSynced, corev1.ConditionFalse, reasonDryRun)
+
+			if policy == monitoringv1beta1.DeletionPolicyDelete {
+				assert.Equal(t, m.monitor().Status.PlannedAction, monitoringv1beta1.PlannedActionDelete)
+				assert.Equal(t, m.lastEvent(), "Normal DryRun Would delete DataDog monitor 1")
+			} else {
+				assert.Equal(t, m.monitor().Status.PlannedAction, monitoringv1beta1.PlannedActionOrphan)
+				assert.Equal(t, m.lastEvent(), "Normal DryRun Would leave DataDog monitor 1 in place")
+			}
+
+			_, err = m.reconcile()
+			assert.NilError(t, err)
+			assert.Equal(t, m.counter.statusUpdates, 0)
+			assert.Equal(t, m.lastEvent(), "")
+
+			m.reconciler.DryRun = false
+
+			_, err = m.reconcile()
+			assert.NilError(t, err)
+			assert.Equal(t, len(m.monitor().Finalizers), 0)
+
+			_, ok := m.server.Monitor(1)
+			assert.Equal(t, ok, policy == monitoringv1beta1.DeletionPolicyOrphan)
+		})
+	}
+}
+
+func TestMonitorReconcilerOrphan(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.DeletionPolicy = monitoringv1beta1.DeletionPolicyOrphan
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	now := metav1.Now()
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.DeletionTimestamp = &now
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{})
+
+	_, ok := m.server.Monitor(1)
+	assert.Assert(t, ok)
+}
+
+func TestMonitorReconcilerDeleteWithoutAccount(t *testing.T) {
+	for _, policy := range []monitoringv1beta1.DeletionPolicy{monitoringv1beta1.DeletionPolicyDelete, monitoringv1beta1.DeletionPolicyOrphan} {
+		now := metav1.Now()
+		monitor := newTestMonitor()
+		monitor.Finalizers = []string{finalizerName}
+		monitor.DeletionTimestamp = &now
+		monitor.Spec.AccountRef = &corev1.LocalObjectReference{Name: "removed"}
+		monitor.Spec.DeletionPolicy = policy
+		monitor.Status.MonitorID = 1
+
+		m := newMonitorTest(t, monitor)
+
+		_, err := m.reconcile()
+		assert.NilError(t, err)
+
+		assert.DeepEqual(t, m.calls(), []string{})
+		assert.Equal(t, len(m.monitor().Finalizers), 0)
+
+		if policy == monitoringv1beta1.DeletionPolicyDelete {
+			assert.Equal(t, <-m.recorder.Events, `Warning AccountError Leaving DataDog monitor 1 in place: failed to get DatadogAccount default/removed: datadogaccounts.monitoring.datadog.com "removed" not found`)
+		}
+		assert.Equal(t, m.lastEvent(), "Normal Orphaned Left DataDog monitor 1 in place")
+
+		m.server.Close()
+	}
+}
+
+func TestMonitorReconcilerBadRequest(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.Query = ""
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	assert.Equal(t, m.lastEvent(), "Warning BadRequest DataDog API rejected the monitor: The value provided for parameter 'query' is invalid")
+	m.assertCondition(monitoringv1beta1.ConditionError, corev1.ConditionTrue, reasonBadRequest)
+	assert.Equal(t, m.monitor().Status.MonitorID, 0)
+}
+
+func TestMonitorReconcilerTooManyRequests(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	m.server.TooManyRequests(1, time.Minute)
+
+	result, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Equal(t, result.RequeueAfter, time.Minute)
+
+	assert.Equal(t, m.lastEvent(), "Warning RateLimited DataDog API rate limit reached, retrying in 1m0s")
+	m.assertCondition(monitoringv1beta1.ConditionError, corev1.ConditionTrue, reasonRateLimited)
+
+	result, err = m.reconcile()
+	assert.NilError(t, err)
+	assert.Assert(t, result.RequeueAfter > 0 && result.RequeueAfter <= time.Minute)
+
+	assert.DeepEqual(t, m.calls(), []string{})
+	assert.Equal(t, m.monitor().Status.MonitorID, 0)
+}
+
+func TestMonitorReconcilerTooManyRequestsWithoutReset(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	m.server.TooManyRequests(1, 0)
+
+	result, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Equal(t, result.RequeueAfter, defaultRateLimitRetry)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.Equal(t, m.monitor().Status.MonitorID, 1)
+}
+
+func TestMonitorReconcilerMetrics(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.DriftPolicy = monitoringv1beta1.DriftPolicyReport
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	creates := testutil.ToFloat64(monitorOperations.WithLabelValues(operationCreate))
+	drifts := testutil.ToFloat64(monitorDrifts.WithLabelValues(string(monitoringv1beta1.DriftPolicyReport)))
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	assert.Equal(t, testutil.ToFloat64(monitorOperations.WithLabelValues(operationCreate)), creates+1)
+	assert.Equal(t, monitorStates.states[monitorName], syncStateSynced)
+	assert.Assert(t, testutil.ToFloat64(monitorLastSync) > 0)
+
+	ddMonitor, _ := m.server.Monitor(1)
+	ddMonitor.SetName("Changed in DataDog")
+	m.server.SetMonitor(ddMonitor)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.Equal(t, testutil.ToFloat64(monitorDrifts.WithLabelValues(string(monitoringv1beta1.DriftPolicyReport))), drifts+1)
+	assert.Equal(t, monitorStates.states[monitorName], syncStateDrifted)
+	assert.Equal(t, testutil.ToFloat64(monitorsBySyncState.WithLabelValues(syncStateDrifted)), float64(1))
+
+	now := metav1.Now()
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.DeletionTimestamp = &now
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	_, ok := monitorStates.states[monitorName]
+	assert.Assert(t, !ok)
+	assert.Equal(t, testutil.ToFloat64(monitorsBySyncState.WithLabelValues(syncStateDrifted)), float64(0))
+}
+
+func TestMonitorReconcilerPaused(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Annotations = map[string]string{pausedAnnotation: "true"}
+		monitor.Spec.Query = "avg(last_5m):avg:system.cpu.user{*} > 95"
+	})
+
+	ddMonitor, _ := m.server.Monitor(1)
+	ddMonitor.SetName("Changed in DataDog")
+	m.server.SetMonitor(ddMonitor)
+
+	result, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Equal(t, result.RequeueAfter, time.Duration(0))
+
+	assert.DeepEqual(t, m.calls(), []string{})
+	assert.Equal(t, m.lastEvent(), "Normal Paused Paused reconciling, changes are not applied to DataDog until the paused annotation is removed")
+	m.assertCondition(monitoringv1beta1.ConditionPaused, corev1.ConditionTrue, reasonPaused)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{})
+	assert.Equal(t, m.lastEvent(), "")
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		delete(monitor.Annotations, pausedAnnotation)
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "PUT /api/v1/monitor/1"})
+	assert.Equal(t, <-m.recorder.Events, `Warning Resumed Resumed reconciling, DataDog monitor 1 differs from its spec: name: "Changed in DataDog" -> "High CPU", query: "avg(last_5m):avg:system.cpu.user{*} > 90" -> "avg(last_5m):avg:system.cpu.user{*} > 95"`)
+	m.assertCondition(monitoringv1beta1.ConditionPaused, corev1.ConditionFalse, reasonResumed)
+	m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonUpdated)
+
+	ddMonitor, _ = m.server.Monitor(1)
+	assert.Equal(t, ddMonitor.GetName(), "High CPU")
+}
+
+func TestMonitorReconcilerPausedDelete(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	now := metav1.Now()
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Annotations = map[string]string{pausedAnnotation: "true"}
+		monitor.DeletionTimestamp = &now
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"DELETE /api/v1/monitor/1"})
+}
+
+func TestMonitorReconcilerMute(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.Mute = &monitoringv1beta1.MonitorMute{}
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"POST /api/v1/monitor", "POST /api/v1/monitor/1/mute"})
+	assert.Equal(t, m.lastEvent(), "Normal Muted Muted DataDog monitor 1: *")
+	assert.DeepEqual(t, m.monitor().Status.MutedScopes, []monitoringv1beta1.MutedScope{{Scope: "*"}})
+	m.assertCondition(monitoringv1beta1.ConditionMuted, corev1.ConditionTrue, reasonMuted)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+
+	end := metav1.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.Mute = &monitoringv1beta1.MonitorMute{Scopes: []string{"host:web-1"}, End: &end}
+	})
+
+	result, err := m.reconcile()
+	assert.NilError(t, err)
+	assert.Assert(t, result.RequeueAfter > 0 && result.RequeueAfter <= time.Hour)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "POST /api/v1/monitor/1/unmute", "POST /api/v1/monitor/1/mute"})
+	assert.DeepEqual(t, m.monitor().Status.MutedScopes, []monitoringv1beta1.MutedScope{{Scope: "host:web-1", End: &end}})
+
+	ddMonitor, _ := m.server.Monitor(1)
+	assert.DeepEqual(t, ddMonitor.GetOptions().Silenced, map[string]int{"host:web-1": int(end.Unix())})
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.Mute = nil
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1", "POST /api/v1/monitor/1/unmute"})
+	assert.Equal(t, m.lastEvent(), "Normal Unmuted Unmuted DataDog monitor 1")
+	assert.Equal(t, len(m.monitor().Status.MutedScopes), 0)
+	m.assertCondition(monitoringv1beta1.ConditionMuted, corev1.ConditionFalse, reasonUnmuted)
+
+	ddMonitor, _ = m.server.Monitor(1)
+	assert.Equal(t, len(ddMonitor.GetOptions().Silenced), 0)
+}
+
+func TestMonitorReconcilerDryRunMute(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.Mute = &monitoringv1beta1.MonitorMute{}
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	m.reconciler.DryRun = true
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{})
+	assert.Equal(t, m.lastEvent(), "Normal DryRun Would create DataDog monitor; would mute *")
+
+	m.reconciler.DryRun = false
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	m.reconciler.DryRun = true
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.Mute = &monitoringv1beta1.MonitorMute{Scopes: []string{"host:web-1"}}
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+	assert.Equal(t, m.lastEvent(), "Normal DryRun Would mute host:web-1 of DataDog monitor 1")
+	assert.Equal(t, m.monitor().Status.PlannedAction, monitoringv1beta1.PlannedActionUpdate)
+
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.Mute = nil
+		monitor.Spec.Name = "Very high CPU"
+	})
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+	assert.Equal(t, m.lastEvent(), `Normal DryRun Would update DataDog monitor 1: name: "High CPU" -> "Very high CPU"; would unmute all scopes`)
+
+	ddMonitor, _ := m.server.Monitor(1)
+	assert.DeepEqual(t, ddMonitor.GetOptions().Silenced, map[string]int{"*": 0})
+}
+
+func TestMonitorReconcilerMutedInDataDog(t *testing.T) {
+	m := newMonitorTest(t, newTestMonitor())
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.NilError(t, err)
+
+	ddMonitor, _ := m.server.Monitor(1)
+	ddMonitor.Options = &datadog.Options{Silenced: map[string]int{"*": 0}}
+	m.server.SetMonitor(ddMonitor)
+
+	_, err = m.reconcile()
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/1"})
+	assert.DeepEqual(t, m.monitor().Status.MutedScopes, []monitoringv1beta1.MutedScope{{Scope: "*"}})
+	assert.Assert(t, getCondition(m.monitor().Status.Conditions, monitoringv1beta1.ConditionMuted) == nil)
+}
+
+// setDataDogMonitor stores the DataDog monitor the given Monitor would create.
+func (m *monitorTest) setDataDogMonitor(monitor *monitoringv1beta1.Monitor) int {
+	ddMonitor := &datadog.Monitor{}
+	_, err := datadog.ChangeMonitor(ddMonitor, monitor)
+	assert.NilError(m.t, err)
+
+	return m.server.SetMonitor(ddMonitor)
+}
+
+func TestMonitorReconcilerAdopt(t *testing.T) {
+	tests := []struct {
+		name     string
+		adopt    func(monitor *monitoringv1beta1.Monitor, id int)
+		query    string
+		expected []string
+	}{
+		{
+			name:     "unchanged",
+			adopt:    func(monitor *monitoringv1beta1.Monitor, id int) { monitor.Spec.AdoptMonitorID = id },
+			query:    newTestMonitor().Spec.Query,
+			expected: []string{"GET /api/v1/monitor/1"},
+		},
+		{
+			name:     "changed",
+			adopt:    func(monitor *monitoringv1beta1.Monitor, id int) { monitor.Spec.AdoptMonitorID = id },
+			query:    "avg(last_5m):avg:system.cpu.user{*} > 95",
+			expected: []string{"GET /api/v1/monitor/1", "PUT /api/v1/monitor/1"},
+		},
+		{
+			name: "annotation",
+			adopt: func(monitor *monitoringv1beta1.Monitor, id int) {
+				monitor.Annotations = map[string]string{adoptMonitorIDAnnotation: strconv.Itoa(id)}
+			},
+			query:    newTestMonitor().Spec.Query,
+			expected: []string{"GET /api/v1/monitor/1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newMonitorTest(t)
+			defer m.server.Close()
+
+			existing := newTestMonitor()
+			existing.Spec.Query = test.query
+			id := m.setDataDogMonitor(existing)
+
+			monitor := newTestMonitor()
+			test.adopt(monitor, id)
+			assert.NilError(t, m.client.Create(context.Background(), monitor))
+
+			_, err := m.reconcile()
+			assert.NilError(t, err)
+
+			assert.DeepEqual(t, m.calls(), test.expected)
+			assert.Equal(t, m.lastEvent(), "Normal Adopted Adopted DataDog monitor 1")
+			assert.Equal(t, m.monitor().Status.MonitorID, id)
+			assert.DeepEqual(t, m.monitor().Finalizers, []string{finalizerName})
+			m.assertCondition(monitoringv1beta1.ConditionReady, corev1.ConditionTrue, reasonAdopted)
+
+			ddMonitor, _ := m.server.Monitor(id)
+			assert.Equal(t, ddMonitor.GetQuery(), newTestMonitor().Spec.Query)
+		})
+	}
+}
+
+func TestMonitorReconcilerAdoptMissing(t *testing.T) {
+	monitor := newTestMonitor()
+	monitor.Spec.AdoptMonitorID = 42
+
+	m := newMonitorTest(t, monitor)
+	defer m.server.Close()
+
+	_, err := m.reconcile()
+	assert.ErrorContains(t, err, "failed to get DataDog monitor 42 to adopt")
+
+	assert.DeepEqual(t, m.calls(), []string{"GET /api/v1/monitor/42"})
+	assert.Equal(t, m.monitor().Status.MonitorID, 0)
+	assert.Equal(t, len(m.monitor().Finalizers), 0)
+	m.assertCondition(monitoringv1beta1.ConditionError, corev1.ConditionTrue, reasonAPIError)
+}
+
+func TestMonitorReconcilerAdoptConflict(t *testing.T) {
+	owner := newTestMonitor()
+	owner.Name = "owner"
+	owner.Status.MonitorID = 1
+
+	monitor := newTestMonitor()
+	monitor.Spec.AdoptMonitorID = 1
+
+	m := newMonitorTest(t, owner, monitor)
+	defer m.server.Close()
+
+	m.setDataDogMonitor(owner)
+
+	_, err := m.reconcile()
+	assert.ErrorContains(t, err, "DataDog monitor 1 is already managed by Monitor default/owner")
+
+	assert.Equal(t, len(m.calls()), 0)
+	assert.Equal(t, m.lastEvent(), "Warning AdoptConflict DataDog monitor 1 is already managed by Monitor default/owner")
+	assert.Equal(t, m.monitor().Status.MonitorID, 0)
+	m.assertCondition(monitoringv1beta1.ConditionError, corev1.ConditionTrue, reasonAdoptConflict)
+
+	// Monitors in another account can have the same ID.
+	m.update(func(monitor *monitoringv1beta1.Monitor) {
+		monitor.Spec.AccountRef = &corev1.LocalObjectReference{Name: "other"}
+	})
+
+	adoptedBy, err := m.reconciler.adoptedBy(m.monitor())
+	assert.NilError(t, err)
+	assert.Equal(t, adoptedBy, "")
+}
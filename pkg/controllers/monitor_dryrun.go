@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+// planDeletion records what finalizeMonitor would do without touching
+// DataDog. The finalizer is kept so the deletion completes, and the monitor is
+// deleted or orphaned, once the operator runs without --dry-run.
+func (r *MonitorReconciler) planDeletion(req ctrl.Request, monitor *monitoringv1beta1.Monitor) (ctrl.Result, error) {
+	log := r.Log.WithValues("monitor", req.NamespacedName)
+
+	action := monitoringv1beta1.PlannedActionDelete
+	message := fmt.Sprintf("Would delete DataDog monitor %d", monitor.Status.MonitorID)
+
+	if r.deletionPolicy(monitor) == monitoringv1beta1.DeletionPolicyOrphan {
+		action = monitoringv1beta1.PlannedActionOrphan
+		message = fmt.Sprintf("Would leave DataDog monitor %d in place", monitor.Status.MonitorID)
+	}
+
+	log.Info("Dry run", "action", action, "message", message)
+
+	status := &monitor.Status
+	if status.PlannedAction == action {
+		return ctrl.Result{}, nil
+	}
+
+	r.Recorder.Event(monitor, corev1.EventTypeNormal, reasonDryRun, message)
+
+	status.PlannedAction = action
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionSynced, corev1.ConditionFalse, reasonDryRun, message)
+
+	err := r.Status().Update(context.Background(), monitor)
+	if err != nil {
+		return r.handleError(req, monitor, err)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+func planCreation(client datadog.MonitorAPI, monitor *monitoringv1beta1.Monitor) (monitoringv1beta1.PlannedAction, string, error) {
+	id, err := adoptMonitorID(monitor)
+	if err != nil {
+		return "", "", err
+	}
+
+	if id == 0 {
+		return monitoringv1beta1.PlannedActionCreate, withMute("Would create DataDog monitor", planMute(monitor, nil)), nil
+	}
+
+	ddMonitor, err := client.GetMonitor(id)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get DataDog monitor %d to adopt: %w", id, err)
+	}
+
+	desired := monitor.DeepCopy()
+	desired.Status.MonitorID = id
+
+	mute := planMute(monitor, ddMonitor.GetOptions().Silenced)
+
+	diff, err := datadog.ChangeMonitor(ddMonitor, desired)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(diff) > 0 {
+		return monitoringv1beta1.PlannedActionAdopt, withMute(fmt.Sprintf("Would adopt and update DataDog monitor %d: %s", id, diff), mute), nil
+	}
+
+	return monitoringv1beta1.PlannedActionAdopt, withMute(fmt.Sprintf("Would adopt DataDog monitor %d", id), mute), nil
+}
+
+func planUpdate(client datadog.MonitorAPI, monitor *monitoringv1beta1.Monitor) (monitoringv1beta1.PlannedAction, string, error) {
+	ddMonitor, err := client.GetMonitor(monitor.Status.MonitorID)
+	if err != nil {
+		if datadog.IsNotFound(err) {
+			return monitoringv1beta1.PlannedActionCreate, fmt.Sprintf("Would create DataDog monitor again, %d not found", monitor.Status.MonitorID), nil
+		}
+
+		return "", "", err
+	}
+
+	mute := planMute(monitor, ddMonitor.GetOptions().Silenced)
+
+	diff, err := datadog.ChangeMonitor(ddMonitor, monitor)
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(diff) == 0 {
+		if mute != "" {
+			return monitoringv1beta1.PlannedActionUpdate, fmt.Sprintf("Would %s of DataDog monitor %d", mute, monitor.Status.MonitorID), nil
+		}
+
+		return monitoringv1beta1.PlannedActionNone, fmt.Sprintf("DataDog monitor %d is up to date", monitor.Status.MonitorID), nil
+	}
+
+	if isDrifted(monitor) && monitor.Spec.DriftPolicy == monitoringv1beta1.DriftPolicyReport {
+		action := monitoringv1beta1.PlannedActionNone
+		if mute != "" {
+			action = monitoringv1beta1.PlannedActionUpdate
+		}
+
+		return action, withMute(fmt.Sprintf("DataDog monitor %d was changed outside of its spec: %s", monitor.Status.MonitorID, diff), mute), nil
+	}
+
+	return monitoringv1beta1.PlannedActionUpdate, withMute(fmt.Sprintf("Would update DataDog monitor %d: %s", monitor.Status.MonitorID, diff), mute), nil
+}
+
+// planMute describes the mute or unmute muteMonitor would make to match
+// spec.mute, empty when the silenced scopes already match.
+func planMute(monitor *monitoringv1beta1.Monitor, silenced map[string]int) string {
+	if monitor.Spec.Mute == nil && !isMuted(monitor) {
+		return ""
+	}
+
+	desired := desiredMute(monitor.Spec.Mute, time.Now())
+	if reflect.DeepEqual(desired, silencedScopes(mutedScopes(silenced))) {
+		return ""
+	}
+
+	if len(desired) == 0 {
+		return "unmute all scopes"
+	}
+
+	return "mute " + strings.Join(sortedScopes(desired), ", ")
+}
+
+func withMute(message, mute string) string {
+	if mute == "" {
+		return message
+	}
+
+	return message + "; would " + mute
+}
+
+// planMonitor works out the change Reconcile would make to DataDog, using
+// only read calls, and records it in the monitor status and events.
+func (r *MonitorReconciler) planMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor) (ctrl.Result, error) {
+	log := r.Log.WithValues("monitor", req.NamespacedName)
+	observed := monitor.Status.DeepCopy()
+
+	var action monitoringv1beta1.PlannedAction
+	var message string
+	var err error
+
+	if isBeingCreated(monitor) {
+		action, message, err = planCreation(client, monitor)
+	} else {
+		action, message, err = planUpdate(client, monitor)
+	}
+
+	if err != nil {
+		return r.handleError(req, monitor, err)
+	}
+
+	log.Info("Dry run", "action", action, "message", message)
+
+	if action != monitoringv1beta1.PlannedActionNone {
+		r.Recorder.Event(monitor, corev1.EventTypeNormal, reasonDryRun, message)
+	}
+
+	status := &monitor.Status
+	status.PlannedAction = action
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionSynced, corev1.ConditionFalse, reasonDryRun, message)
+
+	if !equality.Semantic.DeepEqual(observed, status) {
+		err = r.Status().Update(context.Background(), monitor)
+	}
+
+	return ctrl.Result{RequeueAfter: r.resyncInterval(monitor)}, err
+}
@@ -0,0 +1,182 @@
+package controllers
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const allScopes = "*"
+
+// mutedScopes returns the silenced scopes of a DataDog monitor, mapped to the
+// UNIX time the mute ends or 0 when it lasts until unmuted, for its status.
+func mutedScopes(silenced map[string]int) []monitoringv1beta1.MutedScope {
+	var muted []monitoringv1beta1.MutedScope
+	for scope, end := range silenced {
+		mutedScope := monitoringv1beta1.MutedScope{Scope: scope}
+		if end > 0 {
+			endTime := metav1.Unix(int64(end), 0)
+			mutedScope.End = &endTime
+		}
+
+		muted = append(muted, mutedScope)
+	}
+
+	sort.Slice(muted, func(i, j int) bool { return muted[i].Scope < muted[j].Scope })
+
+	return muted
+}
+
+// silencedScopes is the reverse of mutedScopes.
+func silencedScopes(muted []monitoringv1beta1.MutedScope) map[string]int {
+	scopes := map[string]int{}
+	for _, scope := range muted {
+		scopes[scope.Scope] = 0
+		if scope.End != nil {
+			scopes[scope.Scope] = int(scope.End.Unix())
+		}
+	}
+
+	return scopes
+}
+
+// desiredMute returns the scopes the spec mutes, nothing once the mute ended.
+func desiredMute(mute *monitoringv1beta1.MonitorMute, now time.Time) map[string]int {
+	scopes := map[string]int{}
+	if mute == nil {
+		return scopes
+	}
+
+	end := 0
+	if mute.End != nil {
+		if !mute.End.After(now) {
+			return scopes
+		}
+
+		end = int(mute.End.Unix())
+	}
+
+	if len(mute.Scopes) == 0 {
+		scopes[allScopes] = end
+	}
+
+	for _, scope := range mute.Scopes {
+		scopes[scope] = end
+	}
+
+	return scopes
+}
+
+// isMuted is true when the operator muted the monitor, a monitor muted in
+// DataDog is only unmuted when its spec.mute is removed after that.
+func isMuted(monitor *monitoringv1beta1.Monitor) bool {
+	condition := getCondition(monitor.Status.Conditions, monitoringv1beta1.ConditionMuted)
+
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+// muteEnd returns how long until the spec mute of the monitor ends, zero when
+// it is not muted or the mute does not end.
+func muteEnd(monitor *monitoringv1beta1.Monitor) time.Duration {
+	if monitor.Spec.Mute == nil || monitor.Spec.Mute.End == nil {
+		return 0
+	}
+
+	end := time.Until(monitor.Spec.Mute.End.Time)
+	if end < 0 {
+		return 0
+	}
+
+	return end
+}
+
+func sortedScopes(scopes map[string]int) []string {
+	sorted := []string{}
+	for scope := range scopes {
+		sorted = append(sorted, scope)
+	}
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// muteMonitor mutes and unmutes the DataDog monitor to match spec.mute,
+// comparing it with the mute state read into the status by the update.
+func (r *MonitorReconciler) muteMonitor(client datadog.MonitorAPI, req ctrl.Request, monitor *monitoringv1beta1.Monitor) error {
+	if monitor.Spec.Mute == nil && !isMuted(monitor) {
+		return nil
+	}
+
+	log := r.Log.WithValues("monitor", req.NamespacedName, "monitor_id", monitor.Status.MonitorID)
+	id := monitor.Status.MonitorID
+	status := &monitor.Status
+
+	desired := desiredMute(monitor.Spec.Mute, time.Now())
+	current := silencedScopes(status.MutedScopes)
+
+	if !reflect.DeepEqual(desired, current) {
+		if len(desired) == 0 {
+			log.Info("Unmuting monitor")
+
+			all := true
+			err := client.UnmuteMonitorScopes(id, &datadog.UnmuteMonitorScopes{AllScopes: &all})
+			if err != nil {
+				return err
+			}
+
+			r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonUnmuted, "Unmuted DataDog monitor %d", id)
+		} else {
+			log.Info("Muting monitor", "scopes", sortedScopes(desired))
+
+			for _, scope := range sortedScopes(current) {
+				if end, ok := desired[scope]; ok && end == current[scope] {
+					continue
+				}
+
+				scope := scope
+				err := client.UnmuteMonitorScopes(id, &datadog.UnmuteMonitorScopes{Scope: &scope})
+				if err != nil {
+					return err
+				}
+			}
+
+			for _, scope := range sortedScopes(desired) {
+				end := desired[scope]
+				if currentEnd, ok := current[scope]; ok && currentEnd == end {
+					continue
+				}
+
+				scope := scope
+				mute := &datadog.MuteMonitorScope{Scope: &scope}
+				if end > 0 {
+					mute.End = &end
+				}
+
+				err := client.MuteMonitorScope(id, mute)
+				if err != nil {
+					return err
+				}
+			}
+
+			r.Recorder.Eventf(monitor, corev1.EventTypeNormal, reasonMuted, "Muted DataDog monitor %d: %s", id, strings.Join(sortedScopes(desired), ", "))
+		}
+
+		status.MutedScopes = mutedScopes(desired)
+	}
+
+	if len(desired) == 0 {
+		status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionMuted, corev1.ConditionFalse, reasonUnmuted, "")
+	} else {
+		status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionMuted, corev1.ConditionTrue, reasonMuted, "Muted scopes: "+strings.Join(sortedScopes(desired), ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+const pausedAnnotation = "monitoring.datadog.com/paused"
+
+func isPaused(monitor *monitoringv1beta1.Monitor) bool {
+	return monitor.Annotations[pausedAnnotation] == "true"
+}
+
+// wasPaused is true for a monitor resuming after being paused, until its
+// first successful sync.
+func wasPaused(monitor *monitoringv1beta1.Monitor) bool {
+	condition := getCondition(monitor.Status.Conditions, monitoringv1beta1.ConditionPaused)
+
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+// pauseMonitor leaves the monitor in DataDog alone while the paused
+// annotation is set, only recording that it is paused.
+func (r *MonitorReconciler) pauseMonitor(req ctrl.Request, monitor *monitoringv1beta1.Monitor) (ctrl.Result, error) {
+	monitorStates.set(req.NamespacedName, syncStatePaused)
+
+	if wasPaused(monitor) {
+		return ctrl.Result{}, nil
+	}
+
+	r.Log.WithValues("monitor", req.NamespacedName).Info("Pausing monitor")
+
+	r.Recorder.Event(monitor, corev1.EventTypeNormal, reasonPaused, "Paused reconciling, changes are not applied to DataDog until the paused annotation is removed")
+
+	status := &monitor.Status
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionPaused, corev1.ConditionTrue, reasonPaused, "Reconciling is paused by the "+pausedAnnotation+" annotation")
+
+	return ctrl.Result{}, r.Status().Update(context.Background(), monitor)
+}
+
+// clearPaused marks a previously paused monitor as resumed.
+func clearPaused(status *monitoringv1beta1.MonitorStatus) {
+	if getCondition(status.Conditions, monitoringv1beta1.ConditionPaused) == nil {
+		return
+	}
+
+	status.Conditions = setCondition(status.Conditions, monitoringv1beta1.ConditionPaused, corev1.ConditionFalse, reasonResumed, "")
+}
@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+)
+
+// resolveMonitors returns the DataDog monitor IDs of the named and selected
+// Monitor objects in the namespace, the returned bool is false when any of
+// them does not exist or has not been created in DataDog yet.
+func resolveMonitors(c client.Client, namespace string, names []string, selector *metav1.LabelSelector) ([]int, bool, error) {
+	ctx := context.Background()
+
+	resolved := true
+	monitorIDs := []int{}
+	seen := map[int]bool{}
+
+	addMonitor := func(monitor *monitoringv1beta1.Monitor) {
+		if isBeingCreated(monitor) {
+			resolved = false
+
+			return
+		}
+
+		if !seen[monitor.Status.MonitorID] {
+			seen[monitor.Status.MonitorID] = true
+			monitorIDs = append(monitorIDs, monitor.Status.MonitorID)
+		}
+	}
+
+	for _, name := range names {
+		monitor := &monitoringv1beta1.Monitor{}
+		err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, monitor)
+		if err != nil {
+			if ignoreNotFound(err) != nil {
+				return nil, false, err
+			}
+
+			resolved = false
+
+			continue
+		}
+
+		addMonitor(monitor)
+	}
+
+	if selector != nil {
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return nil, false, err
+		}
+
+		monitors := &monitoringv1beta1.MonitorList{}
+		err = c.List(ctx, monitors,
+			client.InNamespace(namespace),
+			client.MatchingLabelsSelector{Selector: labelSelector})
+		if err != nil {
+			return nil, false, err
+		}
+
+		for i := range monitors.Items {
+			addMonitor(&monitors.Items[i])
+		}
+	}
+
+	return monitorIDs, resolved, nil
+}
+
+// referencesMonitor reports whether the monitor is one of the names or
+// matches the selector.
+func referencesMonitor(monitor metav1.Object, names []string, selector *metav1.LabelSelector) bool {
+	for _, name := range names {
+		if name == monitor.GetName() {
+			return true
+		}
+	}
+
+	if selector == nil {
+		return false
+	}
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+
+	return labelSelector.Matches(labels.Set(monitor.GetLabels()))
+}
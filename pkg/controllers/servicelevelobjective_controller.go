@@ -0,0 +1,325 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	sloFinalizerName = "monitoring.datadog.com.servicelevelobjective"
+)
+
+// ServiceLevelObjectiveReconciler reconciles a ServiceLevelObjective object
+type ServiceLevelObjectiveReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	DataDogClient *datadog.Client
+	// DryRun logs what would be changed in DataDog without changing it.
+	DryRun bool
+}
+
+func isSLOBeingCreated(slo *monitoringv1alpha1.ServiceLevelObjective) bool {
+	return slo.Status.SLOID == ""
+}
+
+func (r *ServiceLevelObjectiveReconciler) createSLO(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective, monitorIDs []int) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues("slo", req.NamespacedName)
+
+	log.Info("Creating service level objective")
+
+	ddSLO := &datadog.ServiceLevelObjective{}
+	_, err := datadog.ChangeServiceLevelObjective(ddSLO, slo, monitorIDs)
+	if err != nil {
+		return err
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would create service level objective")
+
+		return nil
+	}
+
+	newDDSLO, err := client.CreateServiceLevelObjective(ddSLO)
+	if err != nil {
+		return err
+	}
+
+	slo.Status.SLOID = *newDDSLO.ID
+	slo.Status.MonitorIDs = monitorIDs
+
+	err = r.Status().Update(context.Background(), slo)
+	if err != nil {
+		return err
+	}
+
+	addFinalizer(&slo.ObjectMeta, sloFinalizerName)
+
+	err = r.Update(context.Background(), slo)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully created service level objective", "slo_id", *newDDSLO.ID)
+
+	return nil
+}
+
+func (r *ServiceLevelObjectiveReconciler) updateSLO(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective, monitorIDs []int) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"slo",
+		req.NamespacedName,
+		"slo_id",
+		slo.Status.SLOID,
+	)
+
+	log.Info("Updating service level objective")
+
+	ddSLO, err := client.GetServiceLevelObjective(slo.Status.SLOID)
+	if err != nil {
+		if datadog.IsNotFound(err) {
+			log.Info("Existing service level objective not found, creating again")
+
+			slo.Status.SLOID = ""
+
+			return r.createSLO(req, slo, monitorIDs)
+		}
+
+		return err
+	}
+
+	changed, err := datadog.ChangeServiceLevelObjective(ddSLO, slo, monitorIDs)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		log.Info("Skipping update of unchanged service level objective")
+
+		return nil
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would update service level objective")
+
+		return nil
+	}
+
+	_, err = client.UpdateServiceLevelObjective(ddSLO)
+	if err != nil {
+		return err
+	}
+
+	slo.Status.MonitorIDs = monitorIDs
+
+	err = r.Status().Update(context.Background(), slo)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully updated service level objective")
+
+	return nil
+}
+
+func (r *ServiceLevelObjectiveReconciler) deleteSLO(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"slo",
+		req.NamespacedName,
+		"slo_id",
+		slo.Status.SLOID,
+	)
+
+	if r.DryRun {
+		return r.planDeletion(req, slo)
+	}
+
+	log.Info("Deleting service level objective")
+
+	err := client.DeleteServiceLevelObjective(slo.Status.SLOID)
+	if err != nil {
+		return datadog.IgnoreNotFound(err)
+	}
+
+	removeFinalizer(&slo.ObjectMeta, sloFinalizerName)
+
+	err = r.Update(context.Background(), slo)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully deleted service level objective")
+
+	return nil
+}
+
+// planDeletion records that the objective would be deleted, keeping the
+// finalizer until a run without --dry-run deletes it from DataDog.
+func (r *ServiceLevelObjectiveReconciler) planDeletion(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective) error {
+	message := fmt.Sprintf("Would delete service level objective %s", slo.Status.SLOID)
+
+	r.Log.Info("Dry run", "slo", req.NamespacedName, "message", message)
+
+	if !setResourcePlanned(&slo.Status.Conditions, message) {
+		return nil
+	}
+
+	r.Recorder.Event(slo, corev1.EventTypeNormal, reasonDryRun, message)
+
+	return r.Status().Update(context.Background(), slo)
+}
+
+func (r *ServiceLevelObjectiveReconciler) handleError(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective, err error) (ctrl.Result, error) {
+	log := r.Log.WithValues("slo", req.NamespacedName)
+
+	if datadog.IsBadRequest(err) {
+		log.Error(err, "Bad request to DataDog API", "reason", datadog.ErrorReason(err))
+
+		r.Recorder.Eventf(slo, corev1.EventTypeWarning, reasonBadRequest, "DataDog API rejected the service level objective: %s", datadog.ErrorReason(err))
+
+		r.setError(req, slo, reasonBadRequest, err)
+
+		return ctrl.Result{}, nil
+	} else if datadog.IsForbidden(err) {
+		log.Error(nil, "Failed to authenticate with DataDog API")
+
+		r.Recorder.Event(slo, corev1.EventTypeWarning, reasonForbidden, "Failed to authenticate with DataDog API")
+
+		r.setError(req, slo, reasonForbidden, err)
+
+		return ctrl.Result{}, nil
+	} else {
+		return ctrl.Result{}, err
+	}
+}
+
+// setError records a failed sync with DataDog in the service level objective
+// status.
+func (r *ServiceLevelObjectiveReconciler) setError(req ctrl.Request, slo *monitoringv1alpha1.ServiceLevelObjective, reason string, err error) {
+	setResourceError(&slo.Status.Conditions, reason, err)
+
+	updateErr := r.Status().Update(context.Background(), slo)
+	if updateErr != nil {
+		r.Log.Error(updateErr, "Failed to update service level objective status", "slo", req.NamespacedName)
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=servicelevelobjectives,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=servicelevelobjectives/status,verbs=get;update;patch
+
+func (r *ServiceLevelObjectiveReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	slo := &monitoringv1alpha1.ServiceLevelObjective{}
+	err := r.Get(ctx, req.NamespacedName, slo)
+	if err != nil {
+		return ctrl.Result{}, ignoreNotFound(err)
+	}
+
+	if isBeingDeleted(&slo.ObjectMeta, sloFinalizerName) {
+		err := r.deleteSLO(req, slo)
+		if err != nil {
+			return r.handleError(req, slo, err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	monitorIDs, resolved, err := resolveMonitors(r, slo.Namespace, slo.Spec.Monitors, nil)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Referenced monitors are watched, once they are created in DataDog the
+	// objective is reconciled again.
+	if !resolved {
+		r.Log.Info("Waiting for referenced monitors to be created", "slo", req.NamespacedName)
+
+		return ctrl.Result{}, nil
+	}
+
+	if isSLOBeingCreated(slo) {
+		err = r.createSLO(req, slo, monitorIDs)
+	} else {
+		err = r.updateSLO(req, slo, monitorIDs)
+	}
+
+	if err != nil {
+		return r.handleError(req, slo, err)
+	}
+
+	if !r.DryRun && setResourceSynced(&slo.Status.Conditions) {
+		err = r.Status().Update(ctx, slo)
+	}
+
+	return ctrl.Result{}, err
+}
+
+// monitorToSLOs maps a Monitor to the ServiceLevelObjectives in its namespace
+// referencing it, so they pick up a new monitor ID.
+func (r *ServiceLevelObjectiveReconciler) monitorToSLOs(obj handler.MapObject) []reconcile.Request {
+	slos := &monitoringv1alpha1.ServiceLevelObjectiveList{}
+	err := r.List(context.Background(), slos, client.InNamespace(obj.Meta.GetNamespace()))
+	if err != nil {
+		r.Log.Error(err, "Failed to list service level objectives", "monitor", obj.Meta.GetName())
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, slo := range slos.Items {
+		for _, name := range slo.Spec.Monitors {
+			if name == obj.Meta.GetName() {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: slo.Namespace, Name: slo.Name},
+				})
+
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+func (r *ServiceLevelObjectiveReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&monitoringv1alpha1.ServiceLevelObjective{}).
+		Watches(
+			&source.Kind{Type: &monitoringv1beta1.Monitor{}},
+			&handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(r.monitorToSLOs)},
+		).
+		Complete(r)
+}
@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+var sloName = types.NamespacedName{Namespace: "default", Name: "availability"}
+
+func newTestSLO(monitors ...string) *monitoringv1alpha1.ServiceLevelObjective {
+	return &monitoringv1alpha1.ServiceLevelObjective{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sloName.Namespace,
+			Name:      sloName.Name,
+		},
+		Spec: monitoringv1alpha1.ServiceLevelObjectiveSpec{
+			Type:       "monitor",
+			Name:       "Availability",
+			Thresholds: []monitoringv1alpha1.ServiceLevelObjectiveThreshold{{Timeframe: "7d", Target: "99.9"}},
+			Monitors:   monitors,
+		},
+	}
+}
+
+type sloTest struct {
+	t          *testing.T
+	server     *ddfake.Server
+	client     client.Client
+	recorder   *record.FakeRecorder
+	reconciler *ServiceLevelObjectiveReconciler
+}
+
+func newSLOTest(t *testing.T, objs ...runtime.Object) *sloTest {
+	server := ddfake.NewServer()
+	c := fake.NewFakeClientWithScheme(newTestScheme(t), objs...)
+	recorder := record.NewFakeRecorder(100)
+
+	return &sloTest{
+		t:        t,
+		server:   server,
+		client:   c,
+		recorder: recorder,
+		reconciler: &ServiceLevelObjectiveReconciler{
+			Client:        c,
+			Log:           ctrl.Log.WithName("test"),
+			Recorder:      recorder,
+			DataDogClient: server.Client(),
+		},
+	}
+}
+
+func (s *sloTest) reconcile() {
+	s.server.ResetCalls()
+
+	_, err := s.reconciler.Reconcile(ctrl.Request{NamespacedName: sloName})
+	assert.NilError(s.t, err)
+}
+
+func (s *sloTest) slo() *monitoringv1alpha1.ServiceLevelObjective {
+	slo := &monitoringv1alpha1.ServiceLevelObjective{}
+	assert.NilError(s.t, s.client.Get(context.Background(), sloName, slo))
+
+	return slo
+}
+
+func (s *sloTest) update(change func(slo *monitoringv1alpha1.ServiceLevelObjective)) {
+	slo := s.slo()
+	change(slo)
+	assert.NilError(s.t, s.client.Update(context.Background(), slo))
+}
+
+func (s *sloTest) assertCondition(conditionType monitoringv1alpha1.ConditionType, status corev1.ConditionStatus, reason string) {
+	for _, condition := range s.slo().Status.Conditions {
+		if condition.Type == conditionType {
+			assert.Equal(s.t, condition.Status, status)
+			assert.Equal(s.t, condition.Reason, reason)
+
+			return
+		}
+	}
+
+	s.t.Fatalf("missing condition %s", conditionType)
+}
+
+func TestServiceLevelObjectiveReconciler(t *testing.T) {
+	s := newSLOTest(t,
+		newTestSLO("low-disk", "high-cpu"),
+		newSyncedMonitor("high-cpu", 10),
+		newSyncedMonitor("low-disk", 20),
+	)
+	defer s.server.Close()
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"POST /api/v1/slo"})
+	assert.Equal(t, s.slo().Status.SLOID, "slo-1")
+	assert.DeepEqual(t, s.slo().Status.MonitorIDs, []int{20, 10})
+	assert.DeepEqual(t, s.slo().Finalizers, []string{sloFinalizerName})
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+
+	// DataDog returns monitor IDs sorted and thresholds with display values,
+	// neither is a change.
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"GET /api/v1/slo/slo-1"})
+
+	s.update(func(slo *monitoringv1alpha1.ServiceLevelObjective) {
+		slo.Spec.Thresholds[0].Target = "99.5"
+	})
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"GET /api/v1/slo/slo-1", "PUT /api/v1/slo/slo-1"})
+	ddSLO, _ := s.server.ServiceLevelObjective("slo-1")
+	assert.Equal(t, ddSLO.Thresholds[0].GetTarget(), 99.5)
+
+	now := metav1.Now()
+	s.update(func(slo *monitoringv1alpha1.ServiceLevelObjective) {
+		slo.DeletionTimestamp = &now
+	})
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"DELETE /api/v1/slo/slo-1"})
+	assert.Equal(t, len(s.slo().Finalizers), 0)
+
+	_, ok := s.server.ServiceLevelObjective("slo-1")
+	assert.Assert(t, !ok)
+}
+
+func TestServiceLevelObjectiveReconcilerBadRequest(t *testing.T) {
+	slo := newTestSLO("high-cpu")
+	slo.Spec.Name = " "
+
+	s := newSLOTest(t, slo, newSyncedMonitor("high-cpu", 10))
+	defer s.server.Close()
+
+	s.reconcile()
+
+	assert.Equal(t, lastEvent(s.recorder), "Warning BadRequest DataDog API rejected the service level objective: The value provided for parameter 'name' is invalid")
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonBadRequest)
+	s.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionTrue, reasonBadRequest)
+
+	s.update(func(slo *monitoringv1alpha1.ServiceLevelObjective) {
+		slo.Spec.Name = "Availability"
+	})
+
+	s.reconcile()
+
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+	s.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionFalse, reasonSynced)
+}
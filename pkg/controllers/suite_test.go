@@ -16,19 +16,35 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http/httptest"
 	"path/filepath"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -38,6 +54,8 @@ import (
 var cfg *rest.Config
 var k8sClient client.Client
 var testEnv *envtest.Environment
+var ddServer *ddfake.Server
+var stopManager chan struct{}
 
 func TestAPIs(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -52,7 +70,10 @@ var _ = BeforeSuite(func(done Done) {
 
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths: []string{filepath.Join("..", "config", "crd", "bases")},
+		CRDDirectoryPaths: []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		// Conversion webhooks are off by default before Kubernetes 1.15.
+		KubeAPIServerFlags: append(append([]string{}, envtest.DefaultKubeAPIServerFlags...),
+			"--feature-gates=CustomResourceWebhookConversion=true"),
 	}
 
 	var err error
@@ -63,17 +84,202 @@ var _ = BeforeSuite(func(done Done) {
 	err = monitoringv1alpha1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
+	err = monitoringv1beta1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+
 	// +kubebuilder:scaffold:scheme
 
 	k8sClient, err = client.New(cfg, client.Options{Scheme: scheme.Scheme})
 	Expect(err).ToNot(HaveOccurred())
 	Expect(k8sClient).ToNot(BeNil())
 
+	By("starting the manager against a fake DataDog API")
+	ddServer = ddfake.NewServer()
+
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{Scheme: scheme.Scheme, MetricsBindAddress: "0"})
+	Expect(err).ToNot(HaveOccurred())
+
+	err = (&MonitorReconciler{
+		Client:                mgr.GetClient(),
+		Log:                   ctrl.Log.WithName("controllers").WithName("Monitor"),
+		Recorder:              mgr.GetEventRecorderFor("monitor-controller"),
+		DataDogClient:         ddServer.Client(),
+		DefaultDeletionPolicy: monitoringv1beta1.DeletionPolicyDelete,
+	}).SetupWithManager(mgr)
+	Expect(err).ToNot(HaveOccurred())
+
+	stopManager = make(chan struct{})
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(stopManager)).To(Succeed())
+	}()
+
 	close(done)
 }, 60)
 
 var _ = AfterSuite(func() {
 	By("tearing down the test environment")
+	close(stopManager)
+	ddServer.Close()
+
 	err := testEnv.Stop()
 	Expect(err).ToNot(HaveOccurred())
 })
+
+var _ = Describe("Monitor controller", func() {
+	const timeout = 10 * time.Second
+	const interval = 100 * time.Millisecond
+
+	ctx := context.Background()
+
+	newMonitor := func(name string) *monitoringv1beta1.Monitor {
+		return &monitoringv1beta1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Spec: monitoringv1beta1.MonitorSpec{
+				Type:    "metric alert",
+				Name:    "High CPU",
+				Message: "CPU is high",
+				Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+			},
+		}
+	}
+
+	// created waits for the monitor to be created in DataDog and returns it.
+	created := func(key types.NamespacedName) *monitoringv1beta1.Monitor {
+		monitor := &monitoringv1beta1.Monitor{}
+		Eventually(func() int {
+			Expect(k8sClient.Get(ctx, key, monitor)).To(Succeed())
+			return monitor.Status.MonitorID
+		}, timeout, interval).ShouldNot(BeZero())
+
+		return monitor
+	}
+
+	It("creates the DataDog monitor", func() {
+		monitor := newMonitor("create")
+		Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+
+		key := types.NamespacedName{Namespace: monitor.Namespace, Name: monitor.Name}
+		monitor = created(key)
+
+		Expect(monitor.Finalizers).To(ContainElement(finalizerName))
+		Expect(monitor.Status.Site).To(Equal(ddServer.Client().Site()))
+
+		ddMonitor, ok := ddServer.Monitor(monitor.Status.MonitorID)
+		Expect(ok).To(BeTrue())
+		Expect(ddMonitor.GetName()).To(Equal("High CPU"))
+		Expect(ddMonitor.GetQuery()).To(Equal(monitor.Spec.Query))
+
+		Eventually(func() corev1.ConditionStatus {
+			Expect(k8sClient.Get(ctx, key, monitor)).To(Succeed())
+
+			condition := getCondition(monitor.Status.Conditions, monitoringv1beta1.ConditionReady)
+			if condition == nil {
+				return corev1.ConditionUnknown
+			}
+
+			return condition.Status
+		}, timeout, interval).Should(Equal(corev1.ConditionTrue))
+	})
+
+	It("deletes the DataDog monitor with the Monitor", func() {
+		monitor := newMonitor("delete")
+		Expect(k8sClient.Create(ctx, monitor)).To(Succeed())
+
+		key := types.NamespacedName{Namespace: monitor.Namespace, Name: monitor.Name}
+		monitor = created(key)
+		id := monitor.Status.MonitorID
+
+		Expect(k8sClient.Delete(ctx, monitor)).To(Succeed())
+
+		Eventually(func() bool {
+			return apierrs.IsNotFound(k8sClient.Get(ctx, key, &monitoringv1beta1.Monitor{}))
+		}, timeout, interval).Should(BeTrue())
+
+		_, ok := ddServer.Monitor(id)
+		Expect(ok).To(BeFalse())
+	})
+})
+
+var _ = Describe("Monitor conversion", func() {
+	const timeout = 10 * time.Second
+	const interval = 100 * time.Millisecond
+
+	ctx := context.Background()
+
+	var conversionServer *httptest.Server
+
+	// patchCRD merges the given spec into the installed Monitor CRD.
+	patchCRD := func(spec map[string]interface{}) {
+		patch, err := json.Marshal(map[string]interface{}{"spec": spec})
+		Expect(err).ToNot(HaveOccurred())
+
+		crd := &unstructured.Unstructured{}
+		crd.SetGroupVersionKind(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1beta1", Kind: "CustomResourceDefinition"})
+		crd.SetName("monitors.monitoring.datadog.com")
+
+		Expect(k8sClient.Patch(ctx, crd, client.ConstantPatch(types.MergePatchType, patch))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		webhook := &conversion.Webhook{}
+		Expect(webhook.InjectScheme(scheme.Scheme)).To(Succeed())
+
+		conversionServer = httptest.NewTLSServer(webhook)
+
+		// As config/crd/patches/webhook_in_monitors.yaml and the CA injection
+		// do in a cluster, with the webhook served by the test.
+		patchCRD(map[string]interface{}{
+			"conversion": map[string]interface{}{
+				"strategy": "Webhook",
+				"webhookClientConfig": map[string]interface{}{
+					"url":      conversionServer.URL + "/convert",
+					"caBundle": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: conversionServer.Certificate().Raw}),
+				},
+			},
+		})
+	})
+
+	AfterEach(func() {
+		patchCRD(map[string]interface{}{
+			"conversion": map[string]interface{}{"strategy": "None", "webhookClientConfig": nil},
+		})
+
+		conversionServer.Close()
+	})
+
+	It("keeps v1alpha1 options through v1beta1 storage", func() {
+		options := []byte(`{"thresholds": {"critical": 90}, "notify_no_data": true, "new_option": "kept"}`)
+
+		monitor := &monitoringv1alpha1.Monitor{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "convert"},
+			Spec: monitoringv1alpha1.MonitorSpec{
+				Type:    "metric alert",
+				Name:    "High CPU",
+				Message: "CPU is high",
+				Query:   "avg(last_5m):avg:system.cpu.user{*} > 90",
+				Tags:    []string{"team:a"},
+				Options: &runtime.RawExtension{Raw: options},
+			},
+		}
+
+		// The API server picks up the conversion webhook asynchronously.
+		Eventually(func() error {
+			return k8sClient.Create(ctx, monitor)
+		}, timeout, interval).Should(Succeed())
+
+		key := types.NamespacedName{Namespace: monitor.Namespace, Name: monitor.Name}
+
+		stored := &monitoringv1beta1.Monitor{}
+		Expect(k8sClient.Get(ctx, key, stored)).To(Succeed())
+		Expect(stored.Spec.Options).ToNot(BeNil())
+		Expect(stored.Spec.RawOptions).ToNot(BeNil())
+		Expect(stored.Spec.RawOptions.Raw).To(MatchJSON(`{"new_option": "kept"}`))
+
+		converted := &monitoringv1alpha1.Monitor{}
+		Expect(k8sClient.Get(ctx, key, converted)).To(Succeed())
+		Expect(converted.Spec.Tags).To(Equal(monitor.Spec.Tags))
+		Expect(converted.Spec.Options).ToNot(BeNil())
+		Expect(converted.Spec.Options.Raw).To(MatchJSON(options))
+	})
+})
@@ -0,0 +1,306 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	syntheticsTestFinalizerName = "monitoring.datadog.com.syntheticstest"
+)
+
+// SyntheticsTestReconciler reconciles a SyntheticsTest object
+type SyntheticsTestReconciler struct {
+	client.Client
+	Log           logr.Logger
+	Recorder      record.EventRecorder
+	DataDogClient *datadog.Client
+	// DryRun logs what would be changed in DataDog without changing it.
+	DryRun bool
+}
+
+func isSyntheticsTestBeingCreated(test *monitoringv1alpha1.SyntheticsTest) bool {
+	return test.Status.PublicID == ""
+}
+
+func (r *SyntheticsTestReconciler) createSyntheticsTest(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues("synthetics_test", req.NamespacedName)
+
+	log.Info("Creating synthetics test")
+
+	ddTest := &datadog.SyntheticsTest{}
+	_, err := datadog.ChangeSyntheticsTest(ddTest, test)
+	if err != nil {
+		return err
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would create synthetics test")
+
+		return nil
+	}
+
+	newDDTest, err := client.CreateSyntheticsTest(ddTest)
+	if err != nil {
+		return err
+	}
+
+	test.Status.PublicID = *newDDTest.PublicId
+	test.Status.MonitorID = newDDTest.GetMonitorId()
+
+	err = r.Status().Update(context.Background(), test)
+	if err != nil {
+		return err
+	}
+
+	addFinalizer(&test.ObjectMeta, syntheticsTestFinalizerName)
+
+	err = r.Update(context.Background(), test)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully created synthetics test", "public_id", *newDDTest.PublicId)
+
+	return r.pauseSyntheticsTest(req, test, newDDTest)
+}
+
+func (r *SyntheticsTestReconciler) updateSyntheticsTest(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"synthetics_test",
+		req.NamespacedName,
+		"public_id",
+		test.Status.PublicID,
+	)
+
+	log.Info("Updating synthetics test")
+
+	ddTest, err := client.GetSyntheticsTest(test.Status.PublicID)
+	if err != nil {
+		if datadog.IsNotFound(err) {
+			log.Info("Existing synthetics test not found, creating again")
+
+			test.Status.PublicID = ""
+
+			return r.createSyntheticsTest(req, test)
+		}
+
+		return err
+	}
+
+	changed, err := datadog.ChangeSyntheticsTest(ddTest, test)
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		log.Info("Skipping update of unchanged synthetics test")
+	} else if r.DryRun {
+		log.Info("Dry run, would update synthetics test")
+	} else {
+		_, err = client.UpdateSyntheticsTest(test.Status.PublicID, ddTest)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Successfully updated synthetics test")
+	}
+
+	return r.pauseSyntheticsTest(req, test, ddTest)
+}
+
+// pauseSyntheticsTest pauses or resumes the test when its DataDog status does
+// not match the spec.
+func (r *SyntheticsTestReconciler) pauseSyntheticsTest(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest, ddTest *datadog.SyntheticsTest) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"synthetics_test",
+		req.NamespacedName,
+		"public_id",
+		test.Status.PublicID,
+	)
+
+	if test.Spec.Paused == datadog.IsSyntheticsTestPaused(ddTest) {
+		return nil
+	}
+
+	if r.DryRun {
+		log.Info("Dry run, would change synthetics test status", "paused", test.Spec.Paused)
+
+		return nil
+	}
+
+	if test.Spec.Paused {
+		log.Info("Pausing synthetics test")
+
+		_, err := client.PauseSyntheticsTest(test.Status.PublicID)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Successfully paused synthetics test")
+	} else {
+		log.Info("Resuming synthetics test")
+
+		_, err := client.ResumeSyntheticsTest(test.Status.PublicID)
+		if err != nil {
+			return err
+		}
+
+		log.Info("Successfully resumed synthetics test")
+	}
+
+	return nil
+}
+
+func (r *SyntheticsTestReconciler) deleteSyntheticsTest(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest) error {
+	client := r.DataDogClient
+	log := r.Log.WithValues(
+		"synthetics_test",
+		req.NamespacedName,
+		"public_id",
+		test.Status.PublicID,
+	)
+
+	if r.DryRun {
+		return r.planDeletion(req, test)
+	}
+
+	log.Info("Deleting synthetics test")
+
+	err := client.DeleteSyntheticsTests([]string{test.Status.PublicID})
+	if err != nil {
+		return datadog.IgnoreNotFound(err)
+	}
+
+	removeFinalizer(&test.ObjectMeta, syntheticsTestFinalizerName)
+
+	err = r.Update(context.Background(), test)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Successfully deleted synthetics test")
+
+	return nil
+}
+
+// planDeletion records the planned deletion of the test in its status and
+// events, leaving the finalizer in place for a run without --dry-run.
+func (r *SyntheticsTestReconciler) planDeletion(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest) error {
+	message := fmt.Sprintf("Would delete synthetics test %s", test.Status.PublicID)
+
+	r.Log.Info("Dry run", "synthetics_test", req.NamespacedName, "message", message)
+
+	if !setResourcePlanned(&test.Status.Conditions, message) {
+		return nil
+	}
+
+	r.Recorder.Event(test, corev1.EventTypeNormal, reasonDryRun, message)
+
+	return r.Status().Update(context.Background(), test)
+}
+
+func (r *SyntheticsTestReconciler) handleError(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest, err error) (ctrl.Result, error) {
+	log := r.Log.WithValues("synthetics_test", req.NamespacedName)
+
+	if datadog.IsBadRequest(err) {
+		log.Error(err, "Bad request to DataDog API", "reason", datadog.ErrorReason(err))
+
+		r.Recorder.Eventf(test, corev1.EventTypeWarning, reasonBadRequest, "DataDog API rejected the synthetics test: %s", datadog.ErrorReason(err))
+
+		r.setError(req, test, reasonBadRequest, err)
+
+		return ctrl.Result{}, nil
+	} else if datadog.IsForbidden(err) {
+		log.Error(nil, "Failed to authenticate with DataDog API")
+
+		r.Recorder.Event(test, corev1.EventTypeWarning, reasonForbidden, "Failed to authenticate with DataDog API")
+
+		r.setError(req, test, reasonForbidden, err)
+
+		return ctrl.Result{}, nil
+	} else {
+		return ctrl.Result{}, err
+	}
+}
+
+// setError records a failed sync with DataDog in the synthetics test status.
+func (r *SyntheticsTestReconciler) setError(req ctrl.Request, test *monitoringv1alpha1.SyntheticsTest, reason string, err error) {
+	setResourceError(&test.Status.Conditions, reason, err)
+
+	updateErr := r.Status().Update(context.Background(), test)
+	if updateErr != nil {
+		r.Log.Error(updateErr, "Failed to update synthetics test status", "synthetics_test", req.NamespacedName)
+	}
+}
+
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=syntheticstests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=monitoring.datadog.com,resources=syntheticstests/status,verbs=get;update;patch
+
+func (r *SyntheticsTestReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	test := &monitoringv1alpha1.SyntheticsTest{}
+	err := r.Get(ctx, req.NamespacedName, test)
+	if err != nil {
+		return ctrl.Result{}, ignoreNotFound(err)
+	}
+
+	if isBeingDeleted(&test.ObjectMeta, syntheticsTestFinalizerName) {
+		err := r.deleteSyntheticsTest(req, test)
+		if err != nil {
+			return r.handleError(req, test, err)
+		}
+
+		return ctrl.Result{}, nil
+	}
+
+	if isSyntheticsTestBeingCreated(test) {
+		err = r.createSyntheticsTest(req, test)
+	} else {
+		err = r.updateSyntheticsTest(req, test)
+	}
+
+	if err != nil {
+		return r.handleError(req, test, err)
+	}
+
+	if !r.DryRun && setResourceSynced(&test.Status.Conditions) {
+		err = r.Status().Update(ctx, test)
+	}
+
+	return ctrl.Result{}, err
+}
+
+func (r *SyntheticsTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&monitoringv1alpha1.SyntheticsTest{}).
+		Complete(r)
+}
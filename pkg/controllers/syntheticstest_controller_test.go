@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	ddfake "github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+var syntheticsTestName = types.NamespacedName{Namespace: "default", Name: "homepage"}
+
+func newTestSyntheticsTest() *monitoringv1alpha1.SyntheticsTest {
+	return &monitoringv1alpha1.SyntheticsTest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: syntheticsTestName.Namespace,
+			Name:      syntheticsTestName.Name,
+		},
+		Spec: monitoringv1alpha1.SyntheticsTestSpec{
+			Name:      "Homepage",
+			Subtype:   "http",
+			Locations: []string{"aws:eu-central-1"},
+			Request:   monitoringv1alpha1.SyntheticsTestRequest{URL: "https://example.com", Method: "GET"},
+			Assertions: []monitoringv1alpha1.SyntheticsTestAssertion{
+				{Type: "statusCode", Operator: "is", Target: intstr.FromInt(200)},
+			},
+			Options: monitoringv1alpha1.SyntheticsTestOptions{TickEvery: 60},
+		},
+	}
+}
+
+type syntheticsTestTest struct {
+	t          *testing.T
+	server     *ddfake.Server
+	client     client.Client
+	recorder   *record.FakeRecorder
+	reconciler *SyntheticsTestReconciler
+}
+
+func newSyntheticsTestTest(t *testing.T, objs ...runtime.Object) *syntheticsTestTest {
+	server := ddfake.NewServer()
+	c := fake.NewFakeClientWithScheme(newTestScheme(t), objs...)
+	recorder := record.NewFakeRecorder(100)
+
+	return &syntheticsTestTest{
+		t:        t,
+		server:   server,
+		client:   c,
+		recorder: recorder,
+		reconciler: &SyntheticsTestReconciler{
+			Client:        c,
+			Log:           ctrl.Log.WithName("test"),
+			Recorder:      recorder,
+			DataDogClient: server.Client(),
+		},
+	}
+}
+
+func (s *syntheticsTestTest) reconcile() {
+	s.server.ResetCalls()
+
+	_, err := s.reconciler.Reconcile(ctrl.Request{NamespacedName: syntheticsTestName})
+	assert.NilError(s.t, err)
+}
+
+func (s *syntheticsTestTest) test() *monitoringv1alpha1.SyntheticsTest {
+	test := &monitoringv1alpha1.SyntheticsTest{}
+	assert.NilError(s.t, s.client.Get(context.Background(), syntheticsTestName, test))
+
+	return test
+}
+
+func (s *syntheticsTestTest) update(change func(test *monitoringv1alpha1.SyntheticsTest)) {
+	test := s.test()
+	change(test)
+	assert.NilError(s.t, s.client.Update(context.Background(), test))
+}
+
+func (s *syntheticsTestTest) assertCondition(conditionType monitoringv1alpha1.ConditionType, status corev1.ConditionStatus, reason string) {
+	for _, condition := range s.test().Status.Conditions {
+		if condition.Type == conditionType {
+			assert.Equal(s.t, condition.Status, status)
+			assert.Equal(s.t, condition.Reason, reason)
+
+			return
+		}
+	}
+
+	s.t.Fatalf("missing condition %s", conditionType)
+}
+
+func TestSyntheticsTestReconciler(t *testing.T) {
+	s := newSyntheticsTestTest(t, newTestSyntheticsTest())
+	defer s.server.Close()
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"POST /api/v1/synthetics/tests"})
+	assert.Equal(t, s.test().Status.PublicID, "syn-tst-001")
+	assert.Equal(t, s.test().Status.MonitorID, 2)
+	assert.DeepEqual(t, s.test().Finalizers, []string{syntheticsTestFinalizerName})
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+
+	// DataDog returns number targets as floats and fills in default options,
+	// neither is a change.
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"GET /api/v1/synthetics/tests/syn-tst-001"})
+
+	s.update(func(test *monitoringv1alpha1.SyntheticsTest) {
+		test.Spec.Assertions[0].Target = intstr.FromInt(204)
+	})
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"GET /api/v1/synthetics/tests/syn-tst-001", "PUT /api/v1/synthetics/tests/syn-tst-001"})
+	ddTest, _ := s.server.SyntheticsTest("syn-tst-001")
+	assert.Equal(t, ddTest.Config.Assertions[0].Target, float64(204))
+
+	s.update(func(test *monitoringv1alpha1.SyntheticsTest) {
+		test.Spec.Paused = true
+	})
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"GET /api/v1/synthetics/tests/syn-tst-001", "PUT /api/v1/synthetics/tests/syn-tst-001/status"})
+	ddTest, _ = s.server.SyntheticsTest("syn-tst-001")
+	assert.Assert(t, datadog.IsSyntheticsTestPaused(ddTest))
+
+	now := metav1.Now()
+	s.update(func(test *monitoringv1alpha1.SyntheticsTest) {
+		test.DeletionTimestamp = &now
+	})
+
+	s.reconcile()
+
+	assert.DeepEqual(t, serverCalls(s.server), []string{"POST /api/v1/synthetics/tests/delete"})
+	assert.Equal(t, len(s.test().Finalizers), 0)
+
+	_, ok := s.server.SyntheticsTest("syn-tst-001")
+	assert.Assert(t, !ok)
+}
+
+func TestSyntheticsTestReconcilerBadRequest(t *testing.T) {
+	test := newTestSyntheticsTest()
+	test.Spec.Locations = nil
+
+	s := newSyntheticsTestTest(t, test)
+	defer s.server.Close()
+
+	s.reconcile()
+
+	assert.Equal(t, lastEvent(s.recorder), "Warning BadRequest DataDog API rejected the synthetics test: The value provided for parameter 'locations' is invalid")
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionFalse, reasonBadRequest)
+	s.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionTrue, reasonBadRequest)
+
+	s.update(func(test *monitoringv1alpha1.SyntheticsTest) {
+		test.Spec.Locations = []string{"aws:eu-central-1"}
+	})
+
+	s.reconcile()
+
+	s.assertCondition(monitoringv1alpha1.ConditionReady, corev1.ConditionTrue, reasonSynced)
+	s.assertCondition(monitoringv1alpha1.ConditionError, corev1.ConditionFalse, reasonSynced)
+}
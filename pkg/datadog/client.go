@@ -1,16 +1,446 @@
 package datadog
 
 import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/zorkian/go-datadog-api"
 )
 
-type Client = datadog.Client
+const (
+	APIKeyName         = "DD_API_KEY"
+	ApplicationKeyName = "DD_APPLICATION_KEY"
+	SiteName           = "DD_SITE"
 
-func NewClient() *datadog.Client {
-	apiKey := os.Getenv("DD_API_KEY")
-	appKey := os.Getenv("DD_APPLICATION_KEY")
+	DefaultSite = "datadoghq.com"
+)
+
+var (
+	errNotValidated = errors.New("DataDog credentials have not been validated")
+	errInvalidKeys  = errors.New("DataDog rejected the API key")
+)
+
+// Client wraps the DataDog API client so failed requests are returned as an
+// *APIError instead of the plain error string built by the library. The keys
+// can be swapped at any time with SetKeys.
+type Client struct {
+	mu            sync.RWMutex
+	client        *datadog.Client
+	validationErr error
+	limiter       *RateLimiter
+}
+
+func NewClient() *Client {
+	apiKey := os.Getenv(APIKeyName)
+	appKey := os.Getenv(ApplicationKeyName)
+
+	client := &Client{
+		client:        datadog.NewClient(apiKey, appKey),
+		validationErr: errNotValidated,
+		limiter:       NewRateLimiter(RequestsPerSecond, Burst),
+	}
+
+	if site := os.Getenv(SiteName); site != "" {
+		client.SetSite(site)
+	}
+
+	return client
+}
+
+// SiteURL returns the API base URL of a DataDog site such as datadoghq.eu or
+// us3.datadoghq.com, a full URL is returned as is.
+func SiteURL(site string) string {
+	if strings.Contains(site, "://") {
+		return strings.TrimSuffix(site, "/")
+	}
+
+	return "https://api." + site
+}
+
+// SetKeys rebuilds the underlying client with new keys, the previous
+// validation result no longer applies until Validate is called again.
+func (c *Client) SetKeys(apiKey, appKey string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	client := datadog.NewClient(apiKey, appKey)
+	client.SetBaseUrl(c.client.GetBaseUrl())
+	client.HttpClient = c.client.HttpClient
+	client.RetryTimeout = c.client.RetryTimeout
+
+	c.client = client
+	c.validationErr = errNotValidated
+}
+
+// SetRateLimiter replaces the rate limiter requests are queued through.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.limiter = limiter
+}
+
+func (c *Client) SetBaseUrl(baseURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.client.SetBaseUrl(baseURL)
+}
+
+// SetSite points the client at the API of the given DataDog site.
+func (c *Client) SetSite(site string) {
+	c.SetBaseUrl(SiteURL(site))
+}
+
+// Site returns the DataDog site the client talks to.
+func (c *Client) Site() string {
+	baseURL := c.GetBaseUrl()
+
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL
+	}
+
+	return strings.TrimPrefix(u.Host, "api.")
+}
+
+func (c *Client) GetBaseUrl() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.client.GetBaseUrl()
+}
+
+// Validate checks the keys against the DataDog validate endpoint and keeps
+// the result for Ready.
+func (c *Client) Validate() error {
+	err := c.call("Validate", func(client *datadog.Client) error {
+		valid, err := client.Validate()
+		if err == nil && !valid {
+			return errInvalidKeys
+		}
+
+		return err
+	})
+
+	c.mu.Lock()
+	c.validationErr = err
+	c.mu.Unlock()
+
+	return err
+}
+
+// Ready returns the error from the last Validate, if any.
+func (c *Client) Ready() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.validationErr
+}
+
+// responseRecorder keeps hold of the last response made through it, each
+// request waits for the rate limiter first and is counted in the metrics of
+// the endpoint.
+type responseRecorder struct {
+	transport http.RoundTripper
+	limiter   *RateLimiter
+	endpoint  string
+	resp      *http.Response
+	body      []byte
+}
+
+func (r *responseRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := r.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	err := r.limiter.Wait(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	observeRequest(r.endpoint, resp, time.Since(start))
+	if err != nil {
+		return nil, err
+	}
+
+	r.limiter.Update(r.endpoint, resp)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	r.resp = resp
+	r.body = body
+
+	return resp, nil
+}
+
+// call runs fn against a copy of the client that records responses, turning
+// an error caused by a non 2xx response into an *APIError. Nothing is sent
+// while the rate limit of the endpoint is used up.
+func (c *Client) call(endpoint string, fn func(client *datadog.Client) error) error {
+	c.mu.RLock()
+	client := *c.client
+	limiter := c.limiter
+	c.mu.RUnlock()
+
+	if wait := limiter.Blocked(endpoint); wait > 0 {
+		return &APIError{
+			StatusCode: http.StatusTooManyRequests,
+			Errors:     []string{"Rate limit used up"},
+			RetryAfter: wait,
+		}
+	}
+
+	httpClient := client.HttpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	recorder := &responseRecorder{
+		transport: httpClient.Transport,
+		limiter:   limiter,
+		endpoint:  endpoint,
+	}
+
+	client.HttpClient = &http.Client{
+		Transport: recorder,
+		Timeout:   httpClient.Timeout,
+	}
+
+	err := fn(&client)
+	if err == nil {
+		return nil
+	}
+
+	resp := recorder.resp
+	if resp == nil || (resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return err
+	}
+
+	return newAPIError(resp, recorder.body)
+}
+
+func (c *Client) CreateMonitor(monitor *Monitor) (*Monitor, error) {
+	var out *Monitor
+	err := c.call("CreateMonitor", func(client *datadog.Client) (err error) {
+		out, err = client.CreateMonitor(monitor)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetMonitor(id int) (*Monitor, error) {
+	var out *Monitor
+	err := c.call("GetMonitor", func(client *datadog.Client) (err error) {
+		out, err = client.GetMonitor(id)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetMonitors(opts MonitorQueryOpts) ([]Monitor, error) {
+	var out []Monitor
+	err := c.call("GetMonitors", func(client *datadog.Client) (err error) {
+		out, err = client.GetMonitorsWithOptions(opts)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) UpdateMonitor(monitor *Monitor) error {
+	return c.call("UpdateMonitor", func(client *datadog.Client) error {
+		return client.UpdateMonitor(monitor)
+	})
+}
+
+func (c *Client) DeleteMonitor(id int) error {
+	return c.call("DeleteMonitor", func(client *datadog.Client) error {
+		return client.DeleteMonitor(id)
+	})
+}
+
+func (c *Client) MuteMonitorScope(id int, mute *MuteMonitorScope) error {
+	return c.call("MuteMonitorScope", func(client *datadog.Client) error {
+		return client.MuteMonitorScope(id, mute)
+	})
+}
+
+func (c *Client) UnmuteMonitorScopes(id int, unmute *UnmuteMonitorScopes) error {
+	return c.call("UnmuteMonitorScopes", func(client *datadog.Client) error {
+		return client.UnmuteMonitorScopes(id, unmute)
+	})
+}
+
+func (c *Client) CreateBoard(board *Board) (*Board, error) {
+	var out *Board
+	err := c.call("CreateBoard", func(client *datadog.Client) (err error) {
+		out, err = client.CreateBoard(board)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetBoard(id string) (*Board, error) {
+	var out *Board
+	err := c.call("GetBoard", func(client *datadog.Client) (err error) {
+		out, err = client.GetBoard(id)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) UpdateBoard(board *Board) error {
+	return c.call("UpdateBoard", func(client *datadog.Client) error {
+		return client.UpdateBoard(board)
+	})
+}
+
+func (c *Client) DeleteBoard(id string) error {
+	return c.call("DeleteBoard", func(client *datadog.Client) error {
+		return client.DeleteBoard(id)
+	})
+}
+
+func (c *Client) CreateDowntime(downtime *Downtime) (*Downtime, error) {
+	var out *Downtime
+	err := c.call("CreateDowntime", func(client *datadog.Client) (err error) {
+		out, err = client.CreateDowntime(downtime)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetDowntime(id int) (*Downtime, error) {
+	var out *Downtime
+	err := c.call("GetDowntime", func(client *datadog.Client) (err error) {
+		out, err = client.GetDowntime(id)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) UpdateDowntime(downtime *Downtime) error {
+	return c.call("UpdateDowntime", func(client *datadog.Client) error {
+		return client.UpdateDowntime(downtime)
+	})
+}
+
+func (c *Client) DeleteDowntime(id int) error {
+	return c.call("DeleteDowntime", func(client *datadog.Client) error {
+		return client.DeleteDowntime(id)
+	})
+}
+
+func (c *Client) CreateServiceLevelObjective(slo *ServiceLevelObjective) (*ServiceLevelObjective, error) {
+	var out *ServiceLevelObjective
+	err := c.call("CreateServiceLevelObjective", func(client *datadog.Client) (err error) {
+		out, err = client.CreateServiceLevelObjective(slo)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetServiceLevelObjective(id string) (*ServiceLevelObjective, error) {
+	var out *ServiceLevelObjective
+	err := c.call("GetServiceLevelObjective", func(client *datadog.Client) (err error) {
+		out, err = client.GetServiceLevelObjective(id)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) UpdateServiceLevelObjective(slo *ServiceLevelObjective) (*ServiceLevelObjective, error) {
+	var out *ServiceLevelObjective
+	err := c.call("UpdateServiceLevelObjective", func(client *datadog.Client) (err error) {
+		out, err = client.UpdateServiceLevelObjective(slo)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) DeleteServiceLevelObjective(id string) error {
+	return c.call("DeleteServiceLevelObjective", func(client *datadog.Client) error {
+		return client.DeleteServiceLevelObjective(id)
+	})
+}
+
+func (c *Client) CreateSyntheticsTest(test *SyntheticsTest) (*SyntheticsTest, error) {
+	var out *SyntheticsTest
+	err := c.call("CreateSyntheticsTest", func(client *datadog.Client) (err error) {
+		out, err = client.CreateSyntheticsTest(test)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) GetSyntheticsTest(publicID string) (*SyntheticsTest, error) {
+	var out *SyntheticsTest
+	err := c.call("GetSyntheticsTest", func(client *datadog.Client) (err error) {
+		out, err = client.GetSyntheticsTest(publicID)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) UpdateSyntheticsTest(publicID string, test *SyntheticsTest) (*SyntheticsTest, error) {
+	var out *SyntheticsTest
+	err := c.call("UpdateSyntheticsTest", func(client *datadog.Client) (err error) {
+		out, err = client.UpdateSyntheticsTest(publicID, test)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) PauseSyntheticsTest(publicID string) (*bool, error) {
+	var out *bool
+	err := c.call("PauseSyntheticsTest", func(client *datadog.Client) (err error) {
+		out, err = client.PauseSyntheticsTest(publicID)
+		return err
+	})
+
+	return out, err
+}
+
+func (c *Client) ResumeSyntheticsTest(publicID string) (*bool, error) {
+	var out *bool
+	err := c.call("ResumeSyntheticsTest", func(client *datadog.Client) (err error) {
+		out, err = client.ResumeSyntheticsTest(publicID)
+		return err
+	})
+
+	return out, err
+}
 
-	return datadog.NewClient(apiKey, appKey)
+func (c *Client) DeleteSyntheticsTests(publicIDs []string) error {
+	return c.call("DeleteSyntheticsTests", func(client *datadog.Client) error {
+		return client.DeleteSyntheticsTests(publicIDs)
+	})
 }
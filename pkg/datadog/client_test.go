@@ -0,0 +1,69 @@
+package datadog_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func TestClientValidate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api_key") != "valid" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors": ["Forbidden"]}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"valid": true}`)
+	}))
+	defer server.Close()
+
+	client := datadog.NewClient()
+	client.SetBaseUrl(server.URL)
+
+	assert.ErrorContains(t, client.Ready(), "not been validated")
+
+	err := client.Validate()
+	assert.Assert(t, datadog.IsForbidden(err))
+	assert.Equal(t, client.Ready(), err)
+
+	client.SetKeys("valid", "app")
+	assert.Equal(t, client.GetBaseUrl(), server.URL)
+	assert.ErrorContains(t, client.Ready(), "not been validated")
+
+	assert.NilError(t, client.Validate())
+	assert.NilError(t, client.Ready())
+}
+
+func TestSiteURL(t *testing.T) {
+	tests := []struct {
+		site     string
+		expected string
+	}{
+		{"datadoghq.com", "https://api.datadoghq.com"},
+		{"datadoghq.eu", "https://api.datadoghq.eu"},
+		{"us3.datadoghq.com", "https://api.us3.datadoghq.com"},
+		{"ddog-gov.com", "https://api.ddog-gov.com"},
+		{"http://localhost:8080/", "http://localhost:8080"},
+	}
+
+	for _, test := range tests {
+		url := datadog.SiteURL(test.site)
+
+		assert.Equal(t, url, test.expected)
+	}
+}
+
+func TestClientSite(t *testing.T) {
+	client := datadog.NewClient()
+
+	client.SetSite("datadoghq.eu")
+
+	assert.Equal(t, client.GetBaseUrl(), "https://api.datadoghq.eu")
+	assert.Equal(t, client.Site(), "datadoghq.eu")
+}
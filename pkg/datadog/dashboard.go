@@ -0,0 +1,120 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mitchellh/hashstructure"
+	datadog "github.com/zorkian/go-datadog-api"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+)
+
+type Board = datadog.Board
+type BoardWidget = datadog.BoardWidget
+type TemplateVariable = datadog.TemplateVariable
+
+func ChangeBoard(ddBoard *Board, dashboard *monitoringv1alpha1.Dashboard) (bool, error) {
+	spec := dashboard.Spec
+
+	// Widget IDs are assigned by DataDog and never part of the spec, ignore
+	// them so they don't register as a change.
+	for i := range ddBoard.Widgets {
+		ddBoard.Widgets[i].Id = nil
+	}
+
+	originalHash, err := hashstructure.Hash(ddBoard, nil)
+	if err != nil {
+		return false, err
+	}
+
+	widgets, err := toBoardWidgets(spec.Widgets)
+	if err != nil {
+		return false, err
+	}
+
+	templateVariables := []TemplateVariable{}
+	for _, v := range spec.TemplateVariables {
+		v := v
+		templateVariables = append(templateVariables, TemplateVariable{
+			Name:    &v.Name,
+			Prefix:  optionalString(v.Prefix),
+			Default: optionalString(v.Default),
+		})
+	}
+
+	ddBoard.Id = optionalString(dashboard.Status.DashboardID)
+	ddBoard.Title = &spec.Title
+	ddBoard.Description = optionalString(spec.Description)
+	ddBoard.LayoutType = &spec.LayoutType
+	ddBoard.Widgets = widgets
+	ddBoard.TemplateVariables = templateVariables
+	ddBoard.NotifyList = spec.NotifyList
+	ddBoard.IsReadOnly = &spec.ReadOnly
+
+	newHash, err := hashstructure.Hash(ddBoard, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return originalHash != newHash, nil
+}
+
+func toBoardWidgets(specWidgets []monitoringv1alpha1.DashboardWidget) ([]BoardWidget, error) {
+	widgets := []BoardWidget{}
+
+	for i, w := range specWidgets {
+		if w.Definition == nil {
+			return nil, fmt.Errorf("widget %d has no definition", i)
+		}
+
+		var definition struct {
+			Type *string `json:"type"`
+		}
+		err := json.Unmarshal(w.Definition.Raw, &definition)
+		if err != nil {
+			return nil, err
+		}
+		if definition.Type == nil {
+			return nil, fmt.Errorf("widget %d definition has no type", i)
+		}
+
+		raw, err := json.Marshal(map[string]interface{}{
+			"definition": json.RawMessage(w.Definition.Raw),
+			"layout":     toWidgetLayout(w.Layout),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Round trip through BoardWidget so definitions are decoded into the
+		// same typed structs the client returns from GetBoard.
+		widget := BoardWidget{}
+		err = json.Unmarshal(raw, &widget)
+		if err != nil {
+			return nil, err
+		}
+
+		widgets = append(widgets, widget)
+	}
+
+	return widgets, nil
+}
+
+func toWidgetLayout(layout *monitoringv1alpha1.DashboardWidgetLayout) *datadog.WidgetLayout {
+	if layout == nil {
+		return nil
+	}
+
+	x := float64(layout.X)
+	y := float64(layout.Y)
+	width := float64(layout.Width)
+	height := float64(layout.Height)
+
+	return &datadog.WidgetLayout{
+		X:      &x,
+		Y:      &y,
+		Width:  &width,
+		Height: &height,
+	}
+}
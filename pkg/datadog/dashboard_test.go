@@ -0,0 +1,75 @@
+package datadog_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func newDashboard(title string, definitions ...string) *monitoringv1alpha1.Dashboard {
+	widgets := []monitoringv1alpha1.DashboardWidget{}
+	for _, definition := range definitions {
+		widgets = append(widgets, monitoringv1alpha1.DashboardWidget{
+			Definition: &runtime.RawExtension{Raw: []byte(definition)},
+		})
+	}
+
+	return &monitoringv1alpha1.Dashboard{
+		Spec: monitoringv1alpha1.DashboardSpec{
+			Title:      title,
+			LayoutType: "ordered",
+			Widgets:    widgets,
+		},
+		Status: monitoringv1alpha1.DashboardStatus{DashboardID: "abc-def-001"},
+	}
+}
+
+const noteWidget = `{"type": "note", "content": "Hello"}`
+
+func TestChangeBoard(t *testing.T) {
+	ddBoard := &datadog.Board{}
+	changed, err := datadog.ChangeBoard(ddBoard, newDashboard("Overview", noteWidget))
+	assert.NilError(t, err)
+	assert.Assert(t, changed)
+
+	// DataDog assigns each widget an ID
+	for i := range ddBoard.Widgets {
+		id := i + 1
+		ddBoard.Widgets[i].Id = &id
+	}
+
+	tests := []struct {
+		dashboard *monitoringv1alpha1.Dashboard
+		expected  bool
+	}{
+		{newDashboard("Overview", noteWidget), false},
+		{newDashboard("Service overview", noteWidget), true},
+		{newDashboard("Overview", `{"type": "note", "content": "Goodbye"}`), true},
+		{newDashboard("Overview", noteWidget, noteWidget), true},
+	}
+
+	for _, test := range tests {
+		current := *ddBoard
+		current.Widgets = append([]datadog.BoardWidget{}, ddBoard.Widgets...)
+
+		changed, err := datadog.ChangeBoard(&current, test.dashboard)
+
+		assert.NilError(t, err)
+		assert.Equal(t, changed, test.expected)
+	}
+}
+
+func TestChangeBoardInvalidWidget(t *testing.T) {
+	_, err := datadog.ChangeBoard(&datadog.Board{}, newDashboard("Overview", `{"content": "Hello"}`))
+	assert.Error(t, err, "widget 0 definition has no type")
+
+	dashboard := newDashboard("Overview")
+	dashboard.Spec.Widgets = []monitoringv1alpha1.DashboardWidget{{}}
+
+	_, err = datadog.ChangeBoard(&datadog.Board{}, dashboard)
+	assert.Error(t, err, "widget 0 has no definition")
+}
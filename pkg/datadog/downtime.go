@@ -0,0 +1,68 @@
+package datadog
+
+import (
+	"github.com/mitchellh/hashstructure"
+	datadog "github.com/zorkian/go-datadog-api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+)
+
+type Downtime = datadog.Downtime
+type Recurrence = datadog.Recurrence
+
+func ChangeDowntime(ddDowntime *Downtime, downtime *monitoringv1alpha1.Downtime, monitorID int) (bool, error) {
+	spec := downtime.Spec
+
+	originalHash, err := hashstructure.Hash(ddDowntime, nil)
+	if err != nil {
+		return false, err
+	}
+
+	ddDowntime.MonitorId = optionalInt(monitorID)
+	ddDowntime.Scope = spec.Scope
+	ddDowntime.Message = optionalString(spec.Message)
+	ddDowntime.End = unixTime(spec.End)
+	ddDowntime.Recurrence = toRecurrence(spec.Recurrence)
+
+	// DataDog defaults start to now and timezone to UTC, only override them
+	// when given so the defaults don't register as a change.
+	if spec.Start != nil {
+		ddDowntime.Start = unixTime(spec.Start)
+	}
+
+	if spec.Timezone != "" {
+		ddDowntime.Timezone = &spec.Timezone
+	}
+
+	newHash, err := hashstructure.Hash(ddDowntime, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return originalHash != newHash, nil
+}
+
+func toRecurrence(recurrence *monitoringv1alpha1.DowntimeRecurrence) *Recurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &Recurrence{
+		Type:             &recurrence.Type,
+		Period:           &recurrence.Period,
+		WeekDays:         recurrence.WeekDays,
+		UntilDate:        unixTime(recurrence.UntilDate),
+		UntilOccurrences: optionalInt(recurrence.UntilOccurrences),
+	}
+}
+
+func unixTime(t *metav1.Time) *int {
+	if t == nil {
+		return nil
+	}
+
+	unix := int(t.Unix())
+
+	return &unix
+}
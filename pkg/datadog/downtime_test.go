@@ -0,0 +1,54 @@
+package datadog_test
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func newDowntime(message string, start *metav1.Time) *monitoringv1alpha1.Downtime {
+	return &monitoringv1alpha1.Downtime{
+		Spec: monitoringv1alpha1.DowntimeSpec{
+			Scope:   []string{"env:prod"},
+			Message: message,
+			Start:   start,
+		},
+	}
+}
+
+func TestChangeDowntime(t *testing.T) {
+	ddDowntime := &datadog.Downtime{}
+	_, err := datadog.ChangeDowntime(ddDowntime, newDowntime("Maintenance", nil), 1)
+	assert.NilError(t, err)
+
+	// DataDog defaults the start to now and the timezone to UTC
+	ddDowntime.SetStart(1700000000)
+	ddDowntime.SetTimezone("UTC")
+
+	start := metav1.NewTime(time.Unix(1800000000, 0))
+
+	tests := []struct {
+		downtime  *monitoringv1alpha1.Downtime
+		monitorID int
+		expected  bool
+	}{
+		{newDowntime("Maintenance", nil), 1, false},
+		{newDowntime("Upgrade", nil), 1, true},
+		{newDowntime("Maintenance", nil), 2, true},
+		{newDowntime("Maintenance", &start), 1, true},
+	}
+
+	for _, test := range tests {
+		current := *ddDowntime
+
+		changed, err := datadog.ChangeDowntime(&current, test.downtime, test.monitorID)
+
+		assert.NilError(t, err)
+		assert.Equal(t, changed, test.expected)
+	}
+}
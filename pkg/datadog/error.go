@@ -1,31 +1,115 @@
 package datadog
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
 	"strings"
+	"time"
 )
 
-func IsBadRequest(err error) bool {
-	if err == nil {
-		return false
+// APIError is returned for any non 2xx response from the DataDog API.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Errors     []string
+	// RetryAfter is how long until the rate limit resets for a 429.
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("API error %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Reason())
 	}
 
-	return strings.HasPrefix(err.Error(), "API error 400 Bad Request")
+	return fmt.Sprintf("API error %d %s from %s %s: %s",
+		e.StatusCode, http.StatusText(e.StatusCode), e.Method, e.Path, e.Reason())
 }
 
-func IsForbidden(err error) bool {
-	if err == nil {
-		return false
+// Reason returns the errors reported by DataDog in the response body.
+func (e *APIError) Reason() string {
+	return strings.Join(e.Errors, "; ")
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL.Path,
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		apiErr.RetryAfter = rateLimitReset(resp)
+	}
+
+	var errorBody struct {
+		Errors []string `json:"errors"`
+	}
+
+	err := json.Unmarshal(body, &errorBody)
+	if err == nil && len(errorBody.Errors) > 0 {
+		apiErr.Errors = errorBody.Errors
+	} else if len(body) > 0 {
+		apiErr.Errors = []string{string(body)}
 	}
 
-	return strings.HasPrefix(err.Error(), "API error 403 Forbidden")
+	return apiErr
 }
 
-func IsNotFound(err error) bool {
-	if err == nil {
-		return false
+// ErrorReason returns the reason DataDog gave for failing a request, falling
+// back to the error message for errors that did not come from the API.
+func ErrorReason(err error) string {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && len(apiErr.Errors) > 0 {
+		return apiErr.Reason()
+	}
+
+	return err.Error()
+}
+
+func statusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+
+	return 0
+}
+
+// RetryAfter returns how long to wait before retrying a rate limited request.
+func RetryAfter(err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter
 	}
 
-	return strings.HasPrefix(err.Error(), "API error 404 Not Found")
+	return 0
+}
+
+func IsBadRequest(err error) bool {
+	return statusCode(err) == http.StatusBadRequest
+}
+
+func IsForbidden(err error) bool {
+	return statusCode(err) == http.StatusForbidden
+}
+
+func IsNotFound(err error) bool {
+	return statusCode(err) == http.StatusNotFound
+}
+
+func IsConflict(err error) bool {
+	return statusCode(err) == http.StatusConflict
+}
+
+func IsTooManyRequests(err error) bool {
+	return statusCode(err) == http.StatusTooManyRequests
+}
+
+func IsServerError(err error) bool {
+	return statusCode(err) >= http.StatusInternalServerError
 }
 
 func IgnoreNotFound(err error) error {
@@ -2,6 +2,8 @@ package datadog_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"gotest.tools/assert"
@@ -9,13 +11,19 @@ import (
 	"github.com/stefansedich/datadog-operator/pkg/datadog"
 )
 
+func apiError(statusCode int) error {
+	return &datadog.APIError{StatusCode: statusCode}
+}
+
 func TestIsBadRequest(t *testing.T) {
 	tests := []struct {
 		err      error
 		expected bool
 	}{
 		{fmt.Errorf("foo"), false},
-		{fmt.Errorf("API error 400 Bad Request: foo"), true},
+		{fmt.Errorf("API error 400 Bad Request: foo"), false},
+		{apiError(404), false},
+		{apiError(400), true},
 	}
 
 	for _, test := range tests {
@@ -31,7 +39,8 @@ func TestIsForbidden(t *testing.T) {
 		expected bool
 	}{
 		{fmt.Errorf("foo"), false},
-		{fmt.Errorf("API error 403 Forbidden: foo"), true},
+		{apiError(400), false},
+		{apiError(403), true},
 	}
 
 	for _, test := range tests {
@@ -47,7 +56,8 @@ func TestIsNotFound(t *testing.T) {
 		expected bool
 	}{
 		{fmt.Errorf("foo"), false},
-		{fmt.Errorf("API error 404 Not Found: foo"), true},
+		{apiError(400), false},
+		{apiError(404), true},
 	}
 
 	for _, test := range tests {
@@ -57,13 +67,65 @@ func TestIsNotFound(t *testing.T) {
 	}
 }
 
+func TestIsConflict(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{fmt.Errorf("foo"), false},
+		{apiError(400), false},
+		{apiError(409), true},
+	}
+
+	for _, test := range tests {
+		isConflict := datadog.IsConflict(test.err)
+
+		assert.Equal(t, isConflict, test.expected)
+	}
+}
+
+func TestIsTooManyRequests(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{fmt.Errorf("foo"), false},
+		{apiError(400), false},
+		{apiError(429), true},
+	}
+
+	for _, test := range tests {
+		isTooManyRequests := datadog.IsTooManyRequests(test.err)
+
+		assert.Equal(t, isTooManyRequests, test.expected)
+	}
+}
+
+func TestIsServerError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{fmt.Errorf("foo"), false},
+		{apiError(429), false},
+		{apiError(500), true},
+		{apiError(503), true},
+	}
+
+	for _, test := range tests {
+		isServerError := datadog.IsServerError(test.err)
+
+		assert.Equal(t, isServerError, test.expected)
+	}
+}
+
 func TestIgnoreNotFound(t *testing.T) {
 	tests := []struct {
 		err   error
 		is404 bool
 	}{
 		{fmt.Errorf("foo"), false},
-		{fmt.Errorf("API error 404 Not Found: foo"), true},
+		{apiError(404), true},
 	}
 
 	for _, test := range tests {
@@ -76,3 +138,39 @@ func TestIgnoreNotFound(t *testing.T) {
 		}
 	}
 }
+
+func TestErrorReason(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected string
+	}{
+		{fmt.Errorf("foo"), "foo"},
+		{&datadog.APIError{StatusCode: 400, Errors: []string{"foo", "bar"}}, "foo; bar"},
+	}
+
+	for _, test := range tests {
+		reason := datadog.ErrorReason(test.err)
+
+		assert.Equal(t, reason, test.expected)
+	}
+}
+
+func TestClientReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": ["The value provided for parameter 'query' is invalid"]}`)
+	}))
+	defer server.Close()
+
+	client := datadog.NewClient()
+	client.SetBaseUrl(server.URL)
+
+	_, err := client.CreateMonitor(&datadog.Monitor{})
+
+	apiErr, ok := err.(*datadog.APIError)
+	assert.Assert(t, ok)
+	assert.Equal(t, apiErr.StatusCode, http.StatusBadRequest)
+	assert.Equal(t, apiErr.Method, http.MethodPost)
+	assert.Equal(t, apiErr.Path, "/api/v1/monitor")
+	assert.DeepEqual(t, apiErr.Errors, []string{"The value provided for parameter 'query' is invalid"})
+}
@@ -0,0 +1,938 @@
+// Package fake runs an in-process DataDog API serving monitors, dashboards,
+// downtimes, service level objectives and synthetics tests, for testing code
+// built on the datadog package without talking to DataDog.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+const (
+	monitorPath    = "/api/v1/monitor"
+	dashboardPath  = "/api/v1/dashboard"
+	downtimePath   = "/api/v1/downtime"
+	sloPath        = "/api/v1/slo"
+	syntheticsPath = "/api/v1/synthetics/tests"
+)
+
+// Call is a request made to the fake API.
+type Call struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+func (c Call) String() string {
+	return c.Method + " " + c.Path
+}
+
+// Server is a fake DataDog API keeping monitors, dashboards, downtimes,
+// service level objectives and synthetics tests in memory. Each request is
+// recorded and can be failed with a 429 using TooManyRequests.
+type Server struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	monitors        map[int]*datadog.Monitor
+	boards          map[string]*datadog.Board
+	downtimes       map[int]*datadog.Downtime
+	slos            map[string]*datadog.ServiceLevelObjective
+	syntheticsTests map[string]*datadog.SyntheticsTest
+	nextID          int
+	calls           []Call
+	tooManyRequests int
+	rateLimitReset  time.Duration
+}
+
+func NewServer() *Server {
+	s := &Server{
+		monitors:        map[int]*datadog.Monitor{},
+		boards:          map[string]*datadog.Board{},
+		downtimes:       map[int]*datadog.Downtime{},
+		slos:            map[string]*datadog.ServiceLevelObjective{},
+		syntheticsTests: map[string]*datadog.SyntheticsTest{},
+		nextID:          1,
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+// Client returns a client talking to the server.
+func (s *Server) Client() *datadog.Client {
+	client := datadog.NewClient()
+	client.SetKeys("api-key", "application-key")
+	client.SetBaseUrl(s.URL)
+
+	return client
+}
+
+// Calls returns the requests made so far, in order.
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call{}, s.calls...)
+}
+
+// ResetCalls forgets the requests made so far.
+func (s *Server) ResetCalls() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+}
+
+// TooManyRequests fails the next n requests as rate limited, reporting the
+// rate limit to reset after the given duration.
+func (s *Server) TooManyRequests(n int, reset time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tooManyRequests = n
+	s.rateLimitReset = reset
+}
+
+// Monitor returns a copy of the monitor with the given ID.
+func (s *Server) Monitor(id int) (*datadog.Monitor, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	monitor, ok := s.monitors[id]
+	if !ok {
+		return nil, false
+	}
+
+	return copyMonitor(monitor), true
+}
+
+// SetMonitor stores a monitor as if it was changed in DataDog directly,
+// creating it with the next ID when it has none.
+func (s *Server) SetMonitor(monitor *datadog.Monitor) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	monitor = copyMonitor(monitor)
+	if monitor.GetId() == 0 {
+		monitor.SetId(s.nextID)
+		s.nextID++
+	}
+
+	s.monitors[monitor.GetId()] = monitor
+
+	return monitor.GetId()
+}
+
+// RemoveMonitor deletes a monitor as if it was deleted in DataDog directly.
+func (s *Server) RemoveMonitor(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.monitors, id)
+}
+
+// Board returns a copy of the dashboard with the given ID.
+func (s *Server) Board(id string) (*datadog.Board, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	board, ok := s.boards[id]
+	if !ok {
+		return nil, false
+	}
+
+	out := &datadog.Board{}
+	copyJSON(board, out)
+
+	return out, true
+}
+
+// SetBoard stores a dashboard as if it was changed in DataDog directly,
+// creating it with the next ID when it has none.
+func (s *Server) SetBoard(board *datadog.Board) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := &datadog.Board{}
+	copyJSON(board, out)
+
+	if out.GetId() == "" {
+		out.SetId(s.boardID())
+	}
+
+	s.assignWidgetIDs(out)
+	s.boards[out.GetId()] = out
+
+	return out.GetId()
+}
+
+// RemoveBoard deletes a dashboard as if it was deleted in DataDog directly.
+func (s *Server) RemoveBoard(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.boards, id)
+}
+
+// Downtime returns a copy of the downtime with the given ID.
+func (s *Server) Downtime(id int) (*datadog.Downtime, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	downtime, ok := s.downtimes[id]
+	if !ok {
+		return nil, false
+	}
+
+	out := &datadog.Downtime{}
+	copyJSON(downtime, out)
+
+	return out, true
+}
+
+// RemoveDowntime cancels a downtime as if it was cancelled in DataDog directly.
+func (s *Server) RemoveDowntime(id int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.downtimes, id)
+}
+
+// ServiceLevelObjective returns a copy of the service level objective with
+// the given ID.
+func (s *Server) ServiceLevelObjective(id string) (*datadog.ServiceLevelObjective, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	slo, ok := s.slos[id]
+	if !ok {
+		return nil, false
+	}
+
+	out := &datadog.ServiceLevelObjective{}
+	copyJSON(slo, out)
+
+	return out, true
+}
+
+// RemoveServiceLevelObjective deletes a service level objective as if it was
+// deleted in DataDog directly.
+func (s *Server) RemoveServiceLevelObjective(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.slos, id)
+}
+
+// SyntheticsTest returns a copy of the synthetics test with the given public
+// ID.
+func (s *Server) SyntheticsTest(publicID string) (*datadog.SyntheticsTest, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	test, ok := s.syntheticsTests[publicID]
+	if !ok {
+		return nil, false
+	}
+
+	out := &datadog.SyntheticsTest{}
+	copyJSON(test, out)
+
+	return out, true
+}
+
+// RemoveSyntheticsTest deletes a synthetics test as if it was deleted in
+// DataDog directly.
+func (s *Server) RemoveSyntheticsTest(publicID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.syntheticsTests, publicID)
+}
+
+func copyMonitor(monitor *datadog.Monitor) *datadog.Monitor {
+	out := &datadog.Monitor{}
+	copyJSON(monitor, out)
+
+	return out
+}
+
+func copyJSON(in, out interface{}) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		panic(err)
+	}
+
+	err = json.Unmarshal(data, out)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeErrors(w http.ResponseWriter, status int, errors ...string) {
+	writeJSON(w, status, map[string][]string{"errors": errors})
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, Call{Method: r.Method, Path: r.URL.Path, Body: string(body)})
+
+	if s.tooManyRequests > 0 {
+		s.tooManyRequests--
+		w.Header().Set("X-RateLimit-Name", "monitors")
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Period", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.Itoa(int(s.rateLimitReset/time.Second)))
+		writeErrors(w, http.StatusTooManyRequests, "Rate limit exceeded")
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/api/v1/validate":
+		writeJSON(w, http.StatusOK, map[string]bool{"valid": true})
+	case r.URL.Path == monitorPath:
+		s.handleMonitors(w, r, body)
+	case strings.HasPrefix(r.URL.Path, monitorPath+"/"):
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, monitorPath+"/"), "/", 2)
+
+		id, err := strconv.Atoi(parts[0])
+		if err != nil {
+			writeErrors(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		if len(parts) == 1 {
+			s.handleMonitor(w, r, id, body)
+		} else if parts[1] == "mute" || parts[1] == "unmute" {
+			s.handleMute(w, r, id, body, parts[1] == "mute")
+		} else {
+			writeErrors(w, http.StatusNotFound, "Not found")
+		}
+	case r.URL.Path == dashboardPath:
+		s.handleBoards(w, r, body)
+	case strings.HasPrefix(r.URL.Path, dashboardPath+"/"):
+		s.handleBoard(w, r, strings.TrimPrefix(r.URL.Path, dashboardPath+"/"), body)
+	case r.URL.Path == downtimePath:
+		s.handleDowntimes(w, r, body)
+	case strings.HasPrefix(r.URL.Path, downtimePath+"/"):
+		id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, downtimePath+"/"))
+		if err != nil {
+			writeErrors(w, http.StatusNotFound, "Not found")
+			return
+		}
+
+		s.handleDowntime(w, r, id, body)
+	case r.URL.Path == sloPath:
+		s.handleSLOs(w, r, body)
+	case strings.HasPrefix(r.URL.Path, sloPath+"/"):
+		s.handleSLO(w, r, strings.TrimPrefix(r.URL.Path, sloPath+"/"), body)
+	case r.URL.Path == syntheticsPath:
+		s.handleSyntheticsTests(w, r, body)
+	case r.URL.Path == syntheticsPath+"/delete":
+		s.handleSyntheticsDelete(w, r, body)
+	case strings.HasPrefix(r.URL.Path, syntheticsPath+"/"):
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, syntheticsPath+"/"), "/", 2)
+
+		if len(parts) == 1 {
+			s.handleSyntheticsTest(w, r, parts[0], body)
+		} else if parts[1] == "status" {
+			s.handleSyntheticsStatus(w, r, parts[0], body)
+		} else {
+			writeErrors(w, http.StatusNotFound, "Not found")
+		}
+	default:
+		writeErrors(w, http.StatusNotFound, "Not found")
+	}
+}
+
+func (s *Server) handleMonitors(w http.ResponseWriter, r *http.Request, body []byte) {
+	switch r.Method {
+	case http.MethodGet:
+		name := strings.ToLower(r.URL.Query().Get("name"))
+
+		var tags []string
+		if value := r.URL.Query().Get("monitor_tags"); value != "" {
+			tags = strings.Split(value, ",")
+		}
+
+		ids := []int{}
+		for id := range s.monitors {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+
+		monitors := []*datadog.Monitor{}
+		for _, id := range ids {
+			monitor := s.monitors[id]
+			if !strings.Contains(strings.ToLower(monitor.GetName()), name) || !hasTags(monitor, tags) {
+				continue
+			}
+
+			monitors = append(monitors, monitor)
+		}
+
+		writeJSON(w, http.StatusOK, monitors)
+	case http.MethodPost:
+		monitor, errs := decodeMonitor(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		monitor.SetId(s.nextID)
+		s.nextID++
+		s.monitors[monitor.GetId()] = monitor
+
+		writeJSON(w, http.StatusOK, monitor)
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// hasTags reports whether the monitor has all the tags, as the monitor_tags
+// filter of the monitor list does.
+func hasTags(monitor *datadog.Monitor, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, monitorTag := range monitor.Tags {
+			if monitorTag == tag {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) handleMonitor(w http.ResponseWriter, r *http.Request, id int, body []byte) {
+	existing, ok := s.monitors[id]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut:
+		monitor, errs := decodeMonitor(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		monitor.SetId(id)
+		s.monitors[id] = monitor
+
+		writeJSON(w, http.StatusOK, monitor)
+	case http.MethodDelete:
+		delete(s.monitors, id)
+
+		writeJSON(w, http.StatusOK, map[string]int{"deleted_monitor_id": id})
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// handleMute mutes or unmutes a scope of a monitor, the whole monitor when
+// no scope is given.
+func (s *Server) handleMute(w http.ResponseWriter, r *http.Request, id int, body []byte, mute bool) {
+	monitor, ok := s.monitors[id]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Monitor not found")
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	var params struct {
+		Scope     string `json:"scope"`
+		End       int    `json:"end"`
+		AllScopes bool   `json:"all_scopes"`
+	}
+	if len(body) > 0 {
+		err := json.Unmarshal(body, &params)
+		if err != nil {
+			writeErrors(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+			return
+		}
+	}
+
+	scope := params.Scope
+	if scope == "" {
+		scope = "*"
+	}
+
+	if monitor.Options == nil {
+		monitor.Options = &datadog.Options{}
+	}
+	if monitor.Options.Silenced == nil {
+		monitor.Options.Silenced = map[string]int{}
+	}
+
+	switch {
+	case mute:
+		monitor.Options.Silenced[scope] = params.End
+	case params.AllScopes:
+		monitor.Options.Silenced = map[string]int{}
+	default:
+		delete(monitor.Options.Silenced, scope)
+	}
+
+	writeJSON(w, http.StatusOK, monitor)
+}
+
+// decodeMonitor reads a monitor from a request body, returning the errors
+// DataDog would give for a monitor missing required fields.
+func decodeMonitor(body []byte) (*datadog.Monitor, []string) {
+	monitor := &datadog.Monitor{}
+	err := json.Unmarshal(body, monitor)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("Invalid JSON: %v", err)}
+	}
+
+	var errs []string
+	if monitor.GetType() == "" {
+		errs = append(errs, "The value provided for parameter 'type' is invalid")
+	}
+	if strings.TrimSpace(monitor.GetQuery()) == "" {
+		errs = append(errs, "The value provided for parameter 'query' is invalid")
+	}
+
+	return monitor, errs
+}
+
+func (s *Server) boardID() string {
+	id := fmt.Sprintf("abc-def-%03d", s.nextID)
+	s.nextID++
+
+	return id
+}
+
+// assignWidgetIDs gives each widget without one an ID, as DataDog does.
+func (s *Server) assignWidgetIDs(board *datadog.Board) {
+	for i := range board.Widgets {
+		if board.Widgets[i].Id == nil {
+			id := s.nextID
+			s.nextID++
+			board.Widgets[i].Id = &id
+		}
+	}
+}
+
+func (s *Server) handleBoards(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	board, errs := decodeBoard(body)
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	board.SetId(s.boardID())
+	s.assignWidgetIDs(board)
+	s.boards[board.GetId()] = board
+
+	writeJSON(w, http.StatusOK, board)
+}
+
+func (s *Server) handleBoard(w http.ResponseWriter, r *http.Request, id string, body []byte) {
+	existing, ok := s.boards[id]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Dashboard not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut:
+		board, errs := decodeBoard(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		board.SetId(id)
+		s.assignWidgetIDs(board)
+		s.boards[id] = board
+
+		writeJSON(w, http.StatusOK, board)
+	case http.MethodDelete:
+		delete(s.boards, id)
+
+		writeJSON(w, http.StatusOK, map[string]string{"deleted_dashboard_id": id})
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// decodeBoard reads a dashboard from a request body, returning the errors
+// DataDog would give for a dashboard missing required fields.
+func decodeBoard(body []byte) (*datadog.Board, []string) {
+	board := &datadog.Board{}
+	err := json.Unmarshal(body, board)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("Invalid JSON: %v", err)}
+	}
+
+	var errs []string
+	if strings.TrimSpace(board.GetTitle()) == "" {
+		errs = append(errs, "The value provided for parameter 'title' is invalid")
+	}
+	if layout := board.GetLayoutType(); layout != "ordered" && layout != "free" {
+		errs = append(errs, "The value provided for parameter 'layout_type' is invalid")
+	}
+
+	return board, errs
+}
+
+func (s *Server) handleDowntimes(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	downtime, errs := decodeDowntime(body)
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	downtime.SetId(s.nextID)
+	s.nextID++
+	s.downtimes[downtime.GetId()] = downtime
+
+	writeJSON(w, http.StatusOK, downtime)
+}
+
+func (s *Server) handleDowntime(w http.ResponseWriter, r *http.Request, id int, body []byte) {
+	if _, ok := s.downtimes[id]; !ok {
+		writeErrors(w, http.StatusNotFound, "Downtime not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.downtimes[id])
+	case http.MethodPut:
+		downtime, errs := decodeDowntime(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		downtime.SetId(id)
+		s.downtimes[id] = downtime
+
+		writeJSON(w, http.StatusOK, downtime)
+	case http.MethodDelete:
+		delete(s.downtimes, id)
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// decodeDowntime reads a downtime from a request body, filling in the start
+// and timezone defaults DataDog gives a downtime without them.
+func decodeDowntime(body []byte) (*datadog.Downtime, []string) {
+	downtime := &datadog.Downtime{}
+	err := json.Unmarshal(body, downtime)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("Invalid JSON: %v", err)}
+	}
+
+	if len(downtime.Scope) == 0 {
+		return nil, []string{"The value provided for parameter 'scope' is invalid"}
+	}
+
+	if downtime.Start == nil {
+		downtime.SetStart(int(time.Now().Unix()))
+	}
+	if downtime.Timezone == nil {
+		downtime.SetTimezone("UTC")
+	}
+
+	downtime.SetActive(true)
+
+	return downtime, nil
+}
+
+func (s *Server) handleSLOs(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	slo, errs := decodeSLO(body)
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	slo.SetID(fmt.Sprintf("slo-%d", s.nextID))
+	s.nextID++
+	s.slos[slo.GetID()] = slo
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": []*datadog.ServiceLevelObjective{slo}})
+}
+
+func (s *Server) handleSLO(w http.ResponseWriter, r *http.Request, id string, body []byte) {
+	if _, ok := s.slos[id]; !ok {
+		writeErrors(w, http.StatusNotFound, "SLO not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.slos[id]})
+	case http.MethodPut:
+		slo, errs := decodeSLO(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		slo.SetID(id)
+		s.slos[id] = slo
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": []*datadog.ServiceLevelObjective{slo}})
+	case http.MethodDelete:
+		delete(s.slos, id)
+
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": []string{id}})
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+// decodeSLO reads a service level objective from a request body, returning
+// the errors DataDog would give for one missing required fields. As DataDog
+// does, thresholds get display values and monitor IDs come back sorted.
+func decodeSLO(body []byte) (*datadog.ServiceLevelObjective, []string) {
+	slo := &datadog.ServiceLevelObjective{}
+	err := json.Unmarshal(body, slo)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("Invalid JSON: %v", err)}
+	}
+
+	var errs []string
+	if strings.TrimSpace(slo.GetName()) == "" {
+		errs = append(errs, "The value provided for parameter 'name' is invalid")
+	}
+	if len(slo.Thresholds) == 0 {
+		errs = append(errs, "The value provided for parameter 'thresholds' is invalid")
+	}
+
+	switch slo.GetType() {
+	case "monitor":
+		if len(slo.MonitorIDs) == 0 {
+			errs = append(errs, "The value provided for parameter 'monitor_ids' is invalid")
+		}
+	case "metric":
+		if slo.Query == nil {
+			errs = append(errs, "The value provided for parameter 'query' is invalid")
+		}
+	default:
+		errs = append(errs, "The value provided for parameter 'type' is invalid")
+	}
+
+	for _, threshold := range slo.Thresholds {
+		threshold.SetTargetDisplay(fmt.Sprintf("%.3f", threshold.GetTarget()))
+		if threshold.Warning != nil {
+			threshold.SetWarningDisplay(fmt.Sprintf("%.3f", threshold.GetWarning()))
+		}
+	}
+
+	sort.Ints(slo.MonitorIDs)
+
+	return slo, errs
+}
+
+func (s *Server) handleSyntheticsTests(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	test, errs := decodeSyntheticsTest(body)
+	if len(errs) > 0 {
+		writeErrors(w, http.StatusBadRequest, errs...)
+		return
+	}
+
+	test.SetPublicId(fmt.Sprintf("syn-tst-%03d", s.nextID))
+	test.SetMonitorId(s.nextID + 1)
+	s.nextID += 2
+	test.SetStatus("live")
+	test.SetCreatedAt(time.Now().UTC().Format(time.RFC3339))
+
+	// DataDog fills in the options a test is created without.
+	if test.Options.MinLocationFailed == nil {
+		test.Options.SetMinLocationFailed(1)
+	}
+
+	s.syntheticsTests[test.GetPublicId()] = test
+
+	writeJSON(w, http.StatusOK, test)
+}
+
+func (s *Server) handleSyntheticsTest(w http.ResponseWriter, r *http.Request, publicID string, body []byte) {
+	existing, ok := s.syntheticsTests[publicID]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Synthetics test not found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, existing)
+	case http.MethodPut:
+		test, errs := decodeSyntheticsTest(body)
+		if len(errs) > 0 {
+			writeErrors(w, http.StatusBadRequest, errs...)
+			return
+		}
+
+		// The read only fields are kept whatever the update sends.
+		test.PublicId = existing.PublicId
+		test.MonitorId = existing.MonitorId
+		test.Status = existing.Status
+		test.CreatedAt = existing.CreatedAt
+		s.syntheticsTests[publicID] = test
+
+		writeJSON(w, http.StatusOK, test)
+	default:
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+func (s *Server) handleSyntheticsStatus(w http.ResponseWriter, r *http.Request, publicID string, body []byte) {
+	test, ok := s.syntheticsTests[publicID]
+	if !ok {
+		writeErrors(w, http.StatusNotFound, "Synthetics test not found")
+		return
+	}
+
+	if r.Method != http.MethodPut {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	toggle := struct {
+		NewStatus string `json:"new_status"`
+	}{}
+	err := json.Unmarshal(body, &toggle)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+
+	status := toggle.NewStatus
+	if status != "live" && status != "paused" {
+		writeErrors(w, http.StatusBadRequest, "The value provided for parameter 'new_status' is invalid")
+		return
+	}
+
+	test.SetStatus(status)
+
+	writeJSON(w, http.StatusOK, true)
+}
+
+func (s *Server) handleSyntheticsDelete(w http.ResponseWriter, r *http.Request, body []byte) {
+	if r.Method != http.MethodPost {
+		writeErrors(w, http.StatusMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	payload := struct {
+		PublicIDs []string `json:"public_ids"`
+	}{}
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		writeErrors(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+
+	for _, publicID := range payload.PublicIDs {
+		if _, ok := s.syntheticsTests[publicID]; !ok {
+			writeErrors(w, http.StatusNotFound, "Synthetics test not found")
+			return
+		}
+	}
+
+	deleted := []map[string]string{}
+	for _, publicID := range payload.PublicIDs {
+		delete(s.syntheticsTests, publicID)
+		deleted = append(deleted, map[string]string{"public_id": publicID})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"deleted_tests": deleted})
+}
+
+// decodeSyntheticsTest reads a synthetics test from a request body, returning
+// the errors DataDog would give for one missing required fields.
+func decodeSyntheticsTest(body []byte) (*datadog.SyntheticsTest, []string) {
+	test := &datadog.SyntheticsTest{}
+	err := json.Unmarshal(body, test)
+	if err != nil {
+		return nil, []string{fmt.Sprintf("Invalid JSON: %v", err)}
+	}
+
+	var errs []string
+	if strings.TrimSpace(test.GetName()) == "" {
+		errs = append(errs, "The value provided for parameter 'name' is invalid")
+	}
+	if len(test.Locations) == 0 {
+		errs = append(errs, "The value provided for parameter 'locations' is invalid")
+	}
+	if test.Config == nil || test.Config.Request == nil || len(test.Config.Assertions) == 0 {
+		errs = append(errs, "The value provided for parameter 'config' is invalid")
+	}
+	if test.Options == nil || test.Options.TickEvery == nil {
+		errs = append(errs, "The value provided for parameter 'options' is invalid")
+	}
+
+	return test, errs
+}
@@ -0,0 +1,269 @@
+package fake_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+	"github.com/stefansedich/datadog-operator/pkg/datadog/fake"
+)
+
+func newMonitor(query string) *datadog.Monitor {
+	monitor := &datadog.Monitor{}
+	monitor.SetType("metric alert")
+	monitor.SetName("High CPU")
+	monitor.SetQuery(query)
+
+	return monitor
+}
+
+func TestServerMonitors(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	created, err := client.CreateMonitor(newMonitor("avg:cpu > 90"))
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetId(), 1)
+
+	created.SetQuery("avg:cpu > 80")
+	assert.NilError(t, client.UpdateMonitor(created))
+
+	monitor, err := client.GetMonitor(1)
+	assert.NilError(t, err)
+	assert.Equal(t, monitor.GetQuery(), "avg:cpu > 80")
+
+	assert.NilError(t, client.DeleteMonitor(1))
+
+	_, ok := server.Monitor(1)
+	assert.Assert(t, !ok)
+
+	calls := []string{}
+	for _, call := range server.Calls() {
+		calls = append(calls, call.String())
+	}
+
+	assert.DeepEqual(t, calls, []string{
+		"POST /api/v1/monitor",
+		"PUT /api/v1/monitor/1",
+		"GET /api/v1/monitor/1",
+		"DELETE /api/v1/monitor/1",
+	})
+}
+
+func TestServerErrors(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	_, err := client.CreateMonitor(newMonitor(""))
+	assert.Assert(t, datadog.IsBadRequest(err))
+	assert.Equal(t, datadog.ErrorReason(err), "The value provided for parameter 'query' is invalid")
+
+	_, err = client.GetMonitor(42)
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	server.TooManyRequests(1, 0)
+
+	_, err = client.CreateMonitor(newMonitor("avg:cpu > 90"))
+	assert.Assert(t, datadog.IsTooManyRequests(err))
+
+	_, err = client.CreateMonitor(newMonitor("avg:cpu > 90"))
+	assert.NilError(t, err)
+}
+
+func TestServerMute(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	created, err := client.CreateMonitor(newMonitor("avg:cpu > 90"))
+	assert.NilError(t, err)
+
+	scope, end := "host:web-1", 1700000000
+	assert.NilError(t, client.MuteMonitorScope(created.GetId(), &datadog.MuteMonitorScope{}))
+	assert.NilError(t, client.MuteMonitorScope(created.GetId(), &datadog.MuteMonitorScope{Scope: &scope, End: &end}))
+
+	monitor, _ := server.Monitor(created.GetId())
+	assert.DeepEqual(t, monitor.GetOptions().Silenced, map[string]int{"*": 0, "host:web-1": end})
+
+	assert.NilError(t, client.UnmuteMonitorScopes(created.GetId(), &datadog.UnmuteMonitorScopes{Scope: &scope}))
+
+	monitor, _ = server.Monitor(created.GetId())
+	assert.DeepEqual(t, monitor.GetOptions().Silenced, map[string]int{"*": 0})
+
+	all := true
+	assert.NilError(t, client.UnmuteMonitorScopes(created.GetId(), &datadog.UnmuteMonitorScopes{AllScopes: &all}))
+
+	monitor, _ = server.Monitor(created.GetId())
+	assert.Equal(t, len(monitor.GetOptions().Silenced), 0)
+
+	err = client.MuteMonitorScope(42, &datadog.MuteMonitorScope{})
+	assert.Assert(t, datadog.IsNotFound(err))
+}
+
+func TestServerBoards(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	board := &datadog.Board{}
+	assert.NilError(t, json.Unmarshal([]byte(`{
+		"title": "Overview",
+		"layout_type": "ordered",
+		"widgets": [{"definition": {"type": "note", "content": "Hello"}}]
+	}`), board))
+
+	created, err := client.CreateBoard(board)
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetId(), "abc-def-001")
+	assert.Equal(t, *created.Widgets[0].Id, 2)
+
+	created.SetTitle("Service overview")
+	assert.NilError(t, client.UpdateBoard(created))
+
+	got, err := client.GetBoard(created.GetId())
+	assert.NilError(t, err)
+	assert.Equal(t, got.GetTitle(), "Service overview")
+	assert.Equal(t, *got.Widgets[0].Id, 2)
+
+	assert.NilError(t, client.DeleteBoard(created.GetId()))
+
+	_, ok := server.Board(created.GetId())
+	assert.Assert(t, !ok)
+
+	_, err = client.GetBoard(created.GetId())
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	_, err = client.CreateBoard(&datadog.Board{LayoutType: board.LayoutType})
+	assert.Assert(t, datadog.IsBadRequest(err))
+}
+
+func TestServerDowntimes(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	created, err := client.CreateDowntime(&datadog.Downtime{Scope: []string{"env:prod"}})
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetId(), 1)
+	assert.Equal(t, created.GetTimezone(), "UTC")
+	assert.Assert(t, created.GetStart() > 0)
+
+	created.SetMessage("Maintenance")
+	assert.NilError(t, client.UpdateDowntime(created))
+
+	downtime, err := client.GetDowntime(1)
+	assert.NilError(t, err)
+	assert.Equal(t, downtime.GetMessage(), "Maintenance")
+	assert.Equal(t, downtime.GetStart(), created.GetStart())
+
+	assert.NilError(t, client.DeleteDowntime(1))
+
+	_, ok := server.Downtime(1)
+	assert.Assert(t, !ok)
+
+	_, err = client.GetDowntime(1)
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	_, err = client.CreateDowntime(&datadog.Downtime{})
+	assert.Assert(t, datadog.IsBadRequest(err))
+}
+
+func TestServerServiceLevelObjectives(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	slo := &datadog.ServiceLevelObjective{}
+	assert.NilError(t, json.Unmarshal([]byte(`{
+		"type": "monitor",
+		"name": "Availability",
+		"monitor_ids": [20, 10],
+		"thresholds": [{"timeframe": "7d", "target": 99.9}]
+	}`), slo))
+
+	created, err := client.CreateServiceLevelObjective(slo)
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetID(), "slo-1")
+	assert.DeepEqual(t, created.MonitorIDs, []int{10, 20})
+	assert.Equal(t, created.Thresholds[0].GetTargetDisplay(), "99.900")
+
+	created.SetName("Uptime")
+	_, err = client.UpdateServiceLevelObjective(created)
+	assert.NilError(t, err)
+
+	got, err := client.GetServiceLevelObjective("slo-1")
+	assert.NilError(t, err)
+	assert.Equal(t, got.GetName(), "Uptime")
+
+	assert.NilError(t, client.DeleteServiceLevelObjective("slo-1"))
+
+	_, ok := server.ServiceLevelObjective("slo-1")
+	assert.Assert(t, !ok)
+
+	_, err = client.GetServiceLevelObjective("slo-1")
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	_, err = client.CreateServiceLevelObjective(&datadog.ServiceLevelObjective{Type: slo.Type})
+	assert.Assert(t, datadog.IsBadRequest(err))
+}
+
+func TestServerSyntheticsTests(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client := server.Client()
+
+	test := &datadog.SyntheticsTest{}
+	assert.NilError(t, json.Unmarshal([]byte(`{
+		"type": "api",
+		"subtype": "http",
+		"name": "Homepage",
+		"locations": ["aws:eu-central-1"],
+		"config": {
+			"request": {"method": "GET", "url": "https://example.com"},
+			"assertions": [{"type": "statusCode", "operator": "is", "target": 200}]
+		},
+		"options": {"tick_every": 60}
+	}`), test))
+
+	created, err := client.CreateSyntheticsTest(test)
+	assert.NilError(t, err)
+	assert.Equal(t, created.GetPublicId(), "syn-tst-001")
+	assert.Equal(t, created.GetMonitorId(), 2)
+	assert.Equal(t, created.GetStatus(), "live")
+	assert.Equal(t, created.Options.GetMinLocationFailed(), 1)
+	assert.Equal(t, created.Config.Assertions[0].Target, float64(200))
+
+	_, err = client.PauseSyntheticsTest("syn-tst-001")
+	assert.NilError(t, err)
+
+	created.SetName("Landing page")
+	_, err = client.UpdateSyntheticsTest("syn-tst-001", created)
+	assert.NilError(t, err)
+
+	got, err := client.GetSyntheticsTest("syn-tst-001")
+	assert.NilError(t, err)
+	assert.Equal(t, got.GetName(), "Landing page")
+	assert.Equal(t, got.GetStatus(), "paused")
+
+	assert.NilError(t, client.DeleteSyntheticsTests([]string{"syn-tst-001"}))
+
+	_, ok := server.SyntheticsTest("syn-tst-001")
+	assert.Assert(t, !ok)
+
+	err = client.DeleteSyntheticsTests([]string{"syn-tst-001"})
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	_, err = client.CreateSyntheticsTest(&datadog.SyntheticsTest{Name: test.Name})
+	assert.Assert(t, datadog.IsBadRequest(err))
+}
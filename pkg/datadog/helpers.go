@@ -0,0 +1,30 @@
+package datadog
+
+import "sort"
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
+
+func optionalInt(i int) *int {
+	if i == 0 {
+		return nil
+	}
+
+	return &i
+}
+
+func sortedInts(ints []int) []int {
+	if ints == nil {
+		return nil
+	}
+
+	sorted := append([]int{}, ints...)
+	sort.Ints(sorted)
+
+	return sorted
+}
@@ -0,0 +1,53 @@
+package datadog
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	rateLimitRemaining = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "datadog_api_rate_limit_remaining",
+			Help: "Requests left in the current DataDog API rate limit period, by rate limit name",
+		},
+		[]string{"name"},
+	)
+
+	apiRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "datadog_api_requests_total",
+			Help: "Requests made to the DataDog API, by endpoint and status code",
+		},
+		[]string{"endpoint", "status"},
+	)
+
+	apiRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "datadog_api_request_duration_seconds",
+			Help:    "Latency of requests made to the DataDog API, by endpoint",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"endpoint"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(rateLimitRemaining, apiRequests, apiRequestDuration)
+}
+
+// observeRequest records a request to an endpoint, a nil response counts as
+// a request that failed before DataDog answered.
+func observeRequest(endpoint string, resp *http.Response, duration time.Duration) {
+	status := "error"
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+
+	apiRequests.WithLabelValues(endpoint, status).Inc()
+	apiRequestDuration.WithLabelValues(endpoint).Observe(duration.Seconds())
+}
@@ -0,0 +1,70 @@
+package datadog_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gotest.tools/assert"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+// metricValue returns the value of the counter or gauge with the given labels
+// in the controller-runtime registry.
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := metrics.Registry.Gather()
+	assert.NilError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, label := range metric.GetLabel() {
+				if labels[label.GetName()] == label.GetValue() {
+					matched++
+				}
+			}
+
+			if matched != len(labels) {
+				continue
+			}
+
+			if family.GetType().String() == "GAUGE" {
+				return metric.GetGauge().GetValue()
+			}
+
+			return metric.GetCounter().GetValue()
+		}
+	}
+
+	return 0
+}
+
+func TestClientMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Name", "monitors")
+		w.Header().Set("X-RateLimit-Remaining", "99")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors": ["Monitor not found"]}`)
+	}))
+	defer server.Close()
+
+	client := datadog.NewClient()
+	client.SetBaseUrl(server.URL)
+
+	labels := map[string]string{"endpoint": "GetMonitor", "status": "404"}
+	before := metricValue(t, "datadog_api_requests_total", labels)
+
+	_, err := client.GetMonitor(1)
+	assert.Assert(t, datadog.IsNotFound(err))
+
+	assert.Equal(t, metricValue(t, "datadog_api_requests_total", labels), before+1)
+
+	assert.Equal(t, metricValue(t, "datadog_api_rate_limit_remaining", map[string]string{"name": "monitors"}), float64(99))
+}
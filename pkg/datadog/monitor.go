@@ -1,28 +1,131 @@
 package datadog
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 
-	"github.com/mitchellh/hashstructure"
 	datadog "github.com/zorkian/go-datadog-api"
 
-	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
 )
 
 type Monitor = datadog.Monitor
 type Options = datadog.Options
+type MonitorQueryOpts = datadog.MonitorQueryOpts
+type MuteMonitorScope = datadog.MuteMonitorScope
+type UnmuteMonitorScopes = datadog.UnmuteMonitorScopes
 
-func ChangeMonitor(ddMonitor *Monitor, monitor *monitoringv1alpha1.Monitor) (bool, error) {
+// MonitorAPI is the part of the DataDog API used to manage monitors.
+type MonitorAPI interface {
+	// Site returns the DataDog site the monitors are managed in.
+	Site() string
+	CreateMonitor(monitor *Monitor) (*Monitor, error)
+	GetMonitor(id int) (*Monitor, error)
+	UpdateMonitor(monitor *Monitor) error
+	DeleteMonitor(id int) error
+	// MuteMonitorScope mutes the monitor, or one of its scopes.
+	MuteMonitorScope(id int, mute *MuteMonitorScope) error
+	// UnmuteMonitorScopes unmutes the monitor, one of its scopes or all of them.
+	UnmuteMonitorScopes(id int, unmute *UnmuteMonitorScopes) error
+}
+
+var _ MonitorAPI = &Client{}
+
+// Diff lists the fields of a monitor changed by ChangeMonitor.
+type Diff []monitoringv1beta1.FieldDiff
+
+func (d Diff) String() string {
+	unset := func(value string) string {
+		if value == "" {
+			return "<unset>"
+		}
+
+		return value
+	}
+
+	changes := make([]string, len(d))
+	for i, change := range d {
+		changes[i] = fmt.Sprintf("%s: %s -> %s", change.Field, unset(change.Old), unset(change.New))
+	}
+
+	return strings.Join(changes, ", ")
+}
+
+func diffValue(value interface{}) string {
+	var out bytes.Buffer
+
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	encoded := strings.TrimSpace(out.String())
+	if encoded == "null" {
+		return ""
+	}
+
+	return encoded
+}
+
+func (d Diff) add(field string, old, new interface{}) Diff {
+	if reflect.DeepEqual(old, new) {
+		return d
+	}
+
+	return append(d, monitoringv1beta1.FieldDiff{
+		Field: field,
+		Old:   diffValue(old),
+		New:   diffValue(new),
+	})
+}
+
+// optionValues flattens options into their top level JSON keys so each key
+// shows up in the diff on its own.
+func optionValues(options *Options) (map[string]interface{}, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	err = json.Unmarshal(raw, &values)
+
+	return values, err
+}
+
+// ChangeMonitor applies the spec of monitor to ddMonitor, returning the
+// fields that changed.
+func ChangeMonitor(ddMonitor *Monitor, monitor *monitoringv1beta1.Monitor) (Diff, error) {
 	spec := monitor.Spec
 
-	originalHash, err := hashstructure.Hash(ddMonitor, nil)
+	before := *ddMonitor
+	beforeOptions, err := optionValues(ddMonitor.Options)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	err = json.Unmarshal(spec.Options.Raw, &ddMonitor.Options)
+	options, err := spec.MergedOptions()
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+
+	if options != nil {
+		err = json.Unmarshal(options, &ddMonitor.Options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	afterOptions, err := optionValues(ddMonitor.Options)
+	if err != nil {
+		return nil, err
 	}
 
 	ddMonitor.Id = &monitor.Status.MonitorID
@@ -32,10 +135,51 @@ func ChangeMonitor(ddMonitor *Monitor, monitor *monitoringv1alpha1.Monitor) (boo
 	ddMonitor.Query = &spec.Query
 	ddMonitor.Tags = spec.Tags
 
-	newHash, err := hashstructure.Hash(ddMonitor, nil)
+	var diff Diff
+	diff = diff.add("type", before.Type, ddMonitor.Type)
+	diff = diff.add("name", before.Name, ddMonitor.Name)
+	diff = diff.add("query", before.Query, ddMonitor.Query)
+	diff = diff.add("message", before.Message, ddMonitor.Message)
+	if len(before.Tags) > 0 || len(ddMonitor.Tags) > 0 {
+		diff = diff.add("tags", before.Tags, ddMonitor.Tags)
+	}
+
+	keys := []string{}
+	for key := range beforeOptions {
+		keys = append(keys, key)
+	}
+	for key := range afterOptions {
+		if _, ok := beforeOptions[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diff = diff.add("options."+key, beforeOptions[key], afterOptions[key])
+	}
+
+	return diff, nil
+}
+
+// MonitorSpec builds the spec of a Monitor matching an existing DataDog
+// monitor, the reverse of ChangeMonitor.
+func MonitorSpec(ddMonitor *Monitor) (monitoringv1beta1.MonitorSpec, error) {
+	options, err := json.Marshal(ddMonitor.Options)
 	if err != nil {
-		return false, err
+		return monitoringv1beta1.MonitorSpec{}, err
 	}
 
-	return originalHash != newHash, nil
+	spec := monitoringv1beta1.MonitorSpec{
+		Type:           ddMonitor.GetType(),
+		Query:          ddMonitor.GetQuery(),
+		Name:           ddMonitor.GetName(),
+		Message:        ddMonitor.GetMessage(),
+		Tags:           ddMonitor.Tags,
+		AdoptMonitorID: ddMonitor.GetId(),
+	}
+
+	err = spec.SetOptions(options)
+
+	return spec, err
 }
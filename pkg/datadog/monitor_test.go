@@ -0,0 +1,69 @@
+package datadog_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	monitoringv1beta1 "github.com/stefansedich/datadog-operator/api/v1beta1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func newMonitor(query string, tags []string, options string) *monitoringv1beta1.Monitor {
+	return &monitoringv1beta1.Monitor{
+		Spec: monitoringv1beta1.MonitorSpec{
+			Type:       "metric alert",
+			Name:       "High CPU",
+			Message:    "CPU is high",
+			Query:      query,
+			Tags:       tags,
+			RawOptions: &runtime.RawExtension{Raw: []byte(options)},
+		},
+	}
+}
+
+func TestChangeMonitor(t *testing.T) {
+	ddMonitor := &datadog.Monitor{}
+	_, err := datadog.ChangeMonitor(ddMonitor, newMonitor("avg:cpu > 90", []string{"team:a"}, `{"notify_no_data": true}`))
+	assert.NilError(t, err)
+
+	tests := []struct {
+		monitor  *monitoringv1beta1.Monitor
+		expected datadog.Diff
+	}{
+		{newMonitor("avg:cpu > 90", []string{"team:a"}, `{"notify_no_data": true}`), nil},
+		{
+			newMonitor("avg:cpu > 80", []string{"team:a"}, `{"notify_no_data": true}`),
+			datadog.Diff{{Field: "query", Old: `"avg:cpu > 90"`, New: `"avg:cpu > 80"`}},
+		},
+		{
+			newMonitor("avg:cpu > 90", []string{"team:b"}, `{"notify_no_data": false, "renotify_interval": 10}`),
+			datadog.Diff{
+				{Field: "tags", Old: `["team:a"]`, New: `["team:b"]`},
+				{Field: "options.notify_no_data", Old: "true", New: "false"},
+				{Field: "options.renotify_interval", New: "10"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		current := *ddMonitor
+		options := *ddMonitor.Options
+		current.Options = &options
+
+		diff, err := datadog.ChangeMonitor(&current, test.monitor)
+
+		assert.NilError(t, err)
+		assert.DeepEqual(t, diff, test.expected)
+	}
+}
+
+func TestDiffString(t *testing.T) {
+	diff := datadog.Diff{
+		{Field: "query", Old: `"a"`, New: `"b"`},
+		{Field: "options.renotify_interval", New: "10"},
+	}
+
+	assert.Equal(t, diff.String(), `query: "a" -> "b", options.renotify_interval: <unset> -> 10`)
+}
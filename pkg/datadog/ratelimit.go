@@ -0,0 +1,127 @@
+package datadog
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	rateLimitNameHeader      = "X-RateLimit-Name"
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+var (
+	// RequestsPerSecond is the rate clients created by NewClient spread
+	// their requests over, zero or less disables the limit.
+	RequestsPerSecond = 10.0
+	// Burst is the number of requests a client can make at once.
+	Burst = 20
+)
+
+// RateLimiter queues requests through a token bucket and holds the requests
+// of an endpoint once DataDog reports the rate limit it counts against as used
+// up, until it resets. DataDog names each rate limit in the response headers,
+// endpoints are mapped to it as their responses come in.
+type RateLimiter struct {
+	limiter *rate.Limiter
+
+	mu      sync.Mutex
+	names   map[string]string
+	resetAt map[string]time.Time
+}
+
+func NewRateLimiter(requestsPerSecond float64, burst int) *RateLimiter {
+	limit := rate.Limit(requestsPerSecond)
+	if requestsPerSecond <= 0 {
+		limit = rate.Inf
+	}
+
+	return &RateLimiter{
+		limiter: rate.NewLimiter(limit, burst),
+		names:   map[string]string{},
+		resetAt: map[string]time.Time{},
+	}
+}
+
+// Wait blocks until the token bucket allows another request.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	return l.limiter.Wait(ctx)
+}
+
+// Blocked returns how long until DataDog accepts requests to the endpoint
+// again after its rate limit was used up, zero when requests can be made.
+func (l *RateLimiter) Blocked(endpoint string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	wait := time.Until(l.resetAt[l.bucket(endpoint)])
+	if wait < 0 {
+		return 0
+	}
+
+	return wait
+}
+
+// Update records the rate limit headers of a response from the endpoint.
+func (l *RateLimiter) Update(endpoint string, resp *http.Response) {
+	name := resp.Header.Get(rateLimitNameHeader)
+	if name != "" {
+		l.mu.Lock()
+		l.names[endpoint] = name
+		l.mu.Unlock()
+	}
+
+	remaining, err := strconv.Atoi(resp.Header.Get(rateLimitRemainingHeader))
+	if err != nil {
+		if resp.StatusCode == http.StatusTooManyRequests {
+			l.block(endpoint, rateLimitReset(resp))
+		}
+
+		return
+	}
+
+	rateLimitRemaining.WithLabelValues(name).Set(float64(remaining))
+
+	if remaining <= 0 || resp.StatusCode == http.StatusTooManyRequests {
+		l.block(endpoint, rateLimitReset(resp))
+	}
+}
+
+func (l *RateLimiter) block(endpoint string, reset time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	bucket := l.bucket(endpoint)
+
+	resetAt := time.Now().Add(reset)
+	if resetAt.After(l.resetAt[bucket]) {
+		l.resetAt[bucket] = resetAt
+	}
+}
+
+// bucket returns the key the endpoint is blocked under, the name of its rate
+// limit once known and the endpoint itself until then.
+func (l *RateLimiter) bucket(endpoint string) string {
+	if name, ok := l.names[endpoint]; ok {
+		return "name:" + name
+	}
+
+	return "endpoint:" + endpoint
+}
+
+// rateLimitReset returns the time until the rate limit period of a response
+// resets, which DataDog gives in seconds.
+func rateLimitReset(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get(rateLimitResetHeader))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
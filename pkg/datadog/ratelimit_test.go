@@ -0,0 +1,115 @@
+package datadog_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func TestRateLimiterUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		headers    map[string]string
+		blocked    bool
+	}{
+		{
+			name:       "no headers",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "budget left",
+			statusCode: http.StatusOK,
+			headers:    map[string]string{"X-RateLimit-Remaining": "10", "X-RateLimit-Reset": "30"},
+		},
+		{
+			name:       "budget used up",
+			statusCode: http.StatusOK,
+			headers:    map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "30"},
+			blocked:    true,
+		},
+		{
+			name:       "too many requests",
+			statusCode: http.StatusTooManyRequests,
+			headers:    map[string]string{"X-RateLimit-Reset": "30"},
+			blocked:    true,
+		},
+		{
+			name:       "too many requests without reset",
+			statusCode: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limiter := datadog.NewRateLimiter(0, 0)
+
+			resp := &http.Response{StatusCode: test.statusCode, Header: http.Header{}}
+			for name, value := range test.headers {
+				resp.Header.Set(name, value)
+			}
+
+			limiter.Update("GetMonitor", resp)
+
+			blocked := limiter.Blocked("GetMonitor")
+			assert.Equal(t, blocked > 0, test.blocked)
+			assert.Assert(t, blocked <= 30*time.Second)
+		})
+	}
+}
+
+func TestRateLimiterBuckets(t *testing.T) {
+	limiter := datadog.NewRateLimiter(0, 0)
+
+	response := func(name, remaining string) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+		resp.Header.Set("X-RateLimit-Name", name)
+		resp.Header.Set("X-RateLimit-Remaining", remaining)
+		resp.Header.Set("X-RateLimit-Reset", "30")
+		return resp
+	}
+
+	limiter.Update("GetMonitor", response("monitors", "10"))
+	limiter.Update("GetMonitors", response("monitors", "10"))
+	limiter.Update("GetBoard", response("dashboards", "10"))
+
+	limiter.Update("GetMonitors", response("monitors", "0"))
+
+	assert.Assert(t, limiter.Blocked("GetMonitors") > 0)
+	assert.Assert(t, limiter.Blocked("GetMonitor") > 0)
+	assert.Equal(t, limiter.Blocked("GetBoard"), time.Duration(0))
+	assert.Equal(t, limiter.Blocked("GetDowntime"), time.Duration(0))
+}
+
+func TestClientRateLimited(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"errors": ["Rate limit exceeded"]}`))
+	}))
+	defer server.Close()
+
+	client := datadog.NewClient()
+	client.SetBaseUrl(server.URL)
+
+	_, err := client.GetMonitor(1)
+	assert.Assert(t, datadog.IsTooManyRequests(err))
+	assert.Equal(t, datadog.RetryAfter(err), 30*time.Second)
+
+	_, err = client.GetMonitor(1)
+	assert.Assert(t, datadog.IsTooManyRequests(err))
+	assert.Assert(t, datadog.RetryAfter(err) > 0)
+	assert.Equal(t, requests, 1)
+
+	_, err = client.GetBoard("abc")
+	assert.Assert(t, datadog.IsTooManyRequests(err))
+	assert.Equal(t, requests, 2)
+}
@@ -0,0 +1,93 @@
+package datadog
+
+import (
+	"strconv"
+
+	"github.com/mitchellh/hashstructure"
+	datadog "github.com/zorkian/go-datadog-api"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+)
+
+type ServiceLevelObjective = datadog.ServiceLevelObjective
+type ServiceLevelObjectiveThreshold = datadog.ServiceLevelObjectiveThreshold
+type ServiceLevelObjectiveThresholds = datadog.ServiceLevelObjectiveThresholds
+type ServiceLevelObjectiveMetricQuery = datadog.ServiceLevelObjectiveMetricQuery
+
+func ChangeServiceLevelObjective(ddSLO *ServiceLevelObjective, slo *monitoringv1alpha1.ServiceLevelObjective, monitorIDs []int) (bool, error) {
+	spec := slo.Spec
+
+	thresholds, err := toThresholds(spec.Thresholds)
+	if err != nil {
+		return false, err
+	}
+
+	// Thresholds carry read only display values, they are compared separately
+	// using the tolerance aware Equal from the client.
+	originalThresholds := ddSLO.Thresholds
+	ddSLO.Thresholds = nil
+
+	// DataDog does not guarantee the order of monitor IDs, compare them sorted.
+	ddSLO.MonitorIDs = sortedInts(ddSLO.MonitorIDs)
+
+	originalHash, err := hashstructure.Hash(ddSLO, nil)
+	if err != nil {
+		return false, err
+	}
+
+	ddSLO.ID = optionalString(slo.Status.SLOID)
+	ddSLO.Type = &spec.Type
+	ddSLO.Name = &spec.Name
+	ddSLO.Description = optionalString(spec.Description)
+	ddSLO.Tags = spec.Tags
+	ddSLO.Groups = spec.Groups
+	ddSLO.MonitorIDs = sortedInts(monitorIDs)
+	ddSLO.Query = nil
+
+	if spec.Query != nil {
+		ddSLO.Query = &ServiceLevelObjectiveMetricQuery{
+			Numerator:   &spec.Query.Numerator,
+			Denominator: &spec.Query.Denominator,
+		}
+	}
+
+	newHash, err := hashstructure.Hash(ddSLO, nil)
+	if err != nil {
+		return false, err
+	}
+
+	ddSLO.Thresholds = thresholds
+
+	return originalHash != newHash || !originalThresholds.Equal(thresholds), nil
+}
+
+func toThresholds(specThresholds []monitoringv1alpha1.ServiceLevelObjectiveThreshold) (ServiceLevelObjectiveThresholds, error) {
+	thresholds := ServiceLevelObjectiveThresholds{}
+
+	for _, t := range specThresholds {
+		t := t
+
+		target, err := strconv.ParseFloat(t.Target, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		threshold := &ServiceLevelObjectiveThreshold{
+			TimeFrame: &t.Timeframe,
+			Target:    &target,
+		}
+
+		if t.Warning != "" {
+			warning, err := strconv.ParseFloat(t.Warning, 64)
+			if err != nil {
+				return nil, err
+			}
+
+			threshold.Warning = &warning
+		}
+
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, nil
+}
@@ -0,0 +1,90 @@
+package datadog_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func newMonitorSLO() *monitoringv1alpha1.ServiceLevelObjective {
+	return &monitoringv1alpha1.ServiceLevelObjective{
+		Spec: monitoringv1alpha1.ServiceLevelObjectiveSpec{
+			Type:       "monitor",
+			Name:       "Availability",
+			Thresholds: []monitoringv1alpha1.ServiceLevelObjectiveThreshold{{Timeframe: "7d", Target: "99.9"}},
+			Monitors:   []string{"a", "b"},
+		},
+		Status: monitoringv1alpha1.ServiceLevelObjectiveStatus{SLOID: "abc"},
+	}
+}
+
+func TestChangeServiceLevelObjectiveMonitorIDOrder(t *testing.T) {
+	ddSLO := &datadog.ServiceLevelObjective{}
+	_, err := datadog.ChangeServiceLevelObjective(ddSLO, newMonitorSLO(), []int{1, 2})
+	assert.NilError(t, err)
+
+	tests := []struct {
+		current    []int
+		monitorIDs []int
+		expected   bool
+	}{
+		{[]int{1, 2}, []int{1, 2}, false},
+		{[]int{2, 1}, []int{1, 2}, false},
+		{[]int{1, 2}, []int{2, 1}, false},
+		{[]int{1, 2}, []int{1, 3}, true},
+	}
+
+	for _, test := range tests {
+		current := *ddSLO
+		current.MonitorIDs = test.current
+
+		changed, err := datadog.ChangeServiceLevelObjective(&current, newMonitorSLO(), test.monitorIDs)
+
+		assert.NilError(t, err)
+		assert.Equal(t, changed, test.expected)
+	}
+}
+
+func TestChangeServiceLevelObjectiveThresholds(t *testing.T) {
+	target := 99.9
+	nearTarget := 99.9000000001
+	lowerTarget := 99.5
+	warning := 99.95
+	display := "99.900"
+	timeframe := "30d"
+
+	tests := []struct {
+		name      string
+		threshold datadog.ServiceLevelObjectiveThreshold
+		expected  bool
+	}{
+		{"unchanged", datadog.ServiceLevelObjectiveThreshold{Target: &target}, false},
+		{"display values", datadog.ServiceLevelObjectiveThreshold{Target: &target, TargetDisplay: &display}, false},
+		{"tolerance", datadog.ServiceLevelObjectiveThreshold{Target: &nearTarget}, false},
+		{"target", datadog.ServiceLevelObjectiveThreshold{Target: &lowerTarget}, true},
+		{"warning", datadog.ServiceLevelObjectiveThreshold{Target: &target, Warning: &warning}, true},
+		{"timeframe", datadog.ServiceLevelObjectiveThreshold{Target: &target, TimeFrame: &timeframe}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ddSLO := &datadog.ServiceLevelObjective{}
+			_, err := datadog.ChangeServiceLevelObjective(ddSLO, newMonitorSLO(), []int{1, 2})
+			assert.NilError(t, err)
+
+			threshold := test.threshold
+			if threshold.TimeFrame == nil {
+				threshold.TimeFrame = ddSLO.Thresholds[0].TimeFrame
+			}
+			ddSLO.Thresholds = datadog.ServiceLevelObjectiveThresholds{&threshold}
+
+			changed, err := datadog.ChangeServiceLevelObjective(ddSLO, newMonitorSLO(), []int{1, 2})
+
+			assert.NilError(t, err)
+			assert.Equal(t, changed, test.expected)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package datadog
+
+import (
+	"github.com/mitchellh/hashstructure"
+	datadog "github.com/zorkian/go-datadog-api"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+)
+
+type SyntheticsTest = datadog.SyntheticsTest
+type SyntheticsConfig = datadog.SyntheticsConfig
+type SyntheticsRequest = datadog.SyntheticsRequest
+type SyntheticsAssertion = datadog.SyntheticsAssertion
+type SyntheticsOptions = datadog.SyntheticsOptions
+
+const (
+	SyntheticsTestStatusLive   = "live"
+	SyntheticsTestStatusPaused = "paused"
+)
+
+func ChangeSyntheticsTest(ddTest *SyntheticsTest, test *monitoringv1alpha1.SyntheticsTest) (bool, error) {
+	spec := test.Spec
+
+	originalHash, err := hashstructure.Hash(ddTest, nil)
+	if err != nil {
+		return false, err
+	}
+
+	assertions := []SyntheticsAssertion{}
+	for _, a := range spec.Assertions {
+		a := a
+		assertions = append(assertions, SyntheticsAssertion{
+			Type:     &a.Type,
+			Operator: &a.Operator,
+			Property: optionalString(a.Property),
+			Target:   assertionTarget(a.Target),
+		})
+	}
+
+	if ddTest.Config == nil {
+		ddTest.Config = &SyntheticsConfig{}
+	}
+	ddTest.Config.Request = &SyntheticsRequest{
+		Url:     optionalString(spec.Request.URL),
+		Method:  optionalString(spec.Request.Method),
+		Timeout: optionalInt(spec.Request.Timeout),
+		Headers: spec.Request.Headers,
+		Body:    optionalString(spec.Request.Body),
+		Host:    optionalString(spec.Request.Host),
+		Port:    optionalInt(spec.Request.Port),
+	}
+	ddTest.Config.Assertions = assertions
+
+	// Options not given are left to the DataDog defaults so they don't
+	// register as a change.
+	if ddTest.Options == nil {
+		ddTest.Options = &SyntheticsOptions{}
+	}
+	ddTest.Options.TickEvery = &spec.Options.TickEvery
+	if spec.Options.FollowRedirects != nil {
+		ddTest.Options.FollowRedirects = spec.Options.FollowRedirects
+	}
+	if spec.Options.MinFailureDuration != nil {
+		ddTest.Options.MinFailureDuration = spec.Options.MinFailureDuration
+	}
+	if spec.Options.MinLocationFailed != nil {
+		ddTest.Options.MinLocationFailed = spec.Options.MinLocationFailed
+	}
+	if spec.Options.AcceptSelfSigned != nil {
+		ddTest.Options.AcceptSelfSigned = spec.Options.AcceptSelfSigned
+	}
+
+	apiType := "api"
+
+	ddTest.PublicId = optionalString(test.Status.PublicID)
+	ddTest.Type = &apiType
+	ddTest.Subtype = &spec.Subtype
+	ddTest.Name = &spec.Name
+	ddTest.Message = &spec.Message
+	ddTest.Tags = spec.Tags
+	ddTest.Locations = spec.Locations
+
+	if ddTest.Tags == nil {
+		ddTest.Tags = []string{}
+	}
+
+	newHash, err := hashstructure.Hash(ddTest, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return originalHash != newHash, nil
+}
+
+// IsSyntheticsTestPaused reports whether DataDog has the test paused.
+func IsSyntheticsTestPaused(ddTest *SyntheticsTest) bool {
+	return ddTest.Status != nil && *ddTest.Status == SyntheticsTestStatusPaused
+}
+
+// assertionTarget converts the target to the type DataDog returns, numbers
+// are decoded as float64 so they hash the same as the live test.
+func assertionTarget(target intstr.IntOrString) interface{} {
+	if target.Type == intstr.Int {
+		return float64(target.IntVal)
+	}
+
+	return target.StrVal
+}
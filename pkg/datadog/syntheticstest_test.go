@@ -0,0 +1,103 @@
+package datadog_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	monitoringv1alpha1 "github.com/stefansedich/datadog-operator/api/v1alpha1"
+	"github.com/stefansedich/datadog-operator/pkg/datadog"
+)
+
+func newSyntheticsTest(change func(spec *monitoringv1alpha1.SyntheticsTestSpec)) *monitoringv1alpha1.SyntheticsTest {
+	test := &monitoringv1alpha1.SyntheticsTest{
+		Spec: monitoringv1alpha1.SyntheticsTestSpec{
+			Name:      "Homepage",
+			Subtype:   "http",
+			Locations: []string{"aws:eu-central-1"},
+			Request:   monitoringv1alpha1.SyntheticsTestRequest{URL: "https://example.com", Method: "GET"},
+			Assertions: []monitoringv1alpha1.SyntheticsTestAssertion{
+				{Type: "statusCode", Operator: "is", Target: intstr.FromInt(200)},
+				{Type: "header", Operator: "contains", Property: "content-type", Target: intstr.FromString("text/html")},
+			},
+			Options: monitoringv1alpha1.SyntheticsTestOptions{TickEvery: 60},
+		},
+		Status: monitoringv1alpha1.SyntheticsTestStatus{PublicID: "abc-def-ghi"},
+	}
+
+	if change != nil {
+		change(&test.Spec)
+	}
+
+	return test
+}
+
+// liveSyntheticsTest returns the test as DataDog returns it, decoded from
+// JSON with its defaults filled in.
+func liveSyntheticsTest(t *testing.T) *datadog.SyntheticsTest {
+	ddTest := &datadog.SyntheticsTest{}
+	_, err := datadog.ChangeSyntheticsTest(ddTest, newSyntheticsTest(nil))
+	assert.NilError(t, err)
+
+	ddTest.SetStatus(datadog.SyntheticsTestStatusLive)
+	ddTest.SetMonitorId(1)
+	ddTest.Options.SetMinLocationFailed(1)
+
+	body, err := json.Marshal(ddTest)
+	assert.NilError(t, err)
+
+	live := &datadog.SyntheticsTest{}
+	assert.NilError(t, json.Unmarshal(body, live))
+
+	return live
+}
+
+func TestChangeSyntheticsTest(t *testing.T) {
+	followRedirects := true
+	minLocationFailed := 2
+
+	tests := []struct {
+		name     string
+		test     *monitoringv1alpha1.SyntheticsTest
+		expected bool
+	}{
+		{"unchanged", newSyntheticsTest(nil), false},
+		{"name", newSyntheticsTest(func(spec *monitoringv1alpha1.SyntheticsTestSpec) {
+			spec.Name = "Landing page"
+		}), true},
+		{"number target", newSyntheticsTest(func(spec *monitoringv1alpha1.SyntheticsTestSpec) {
+			spec.Assertions[0].Target = intstr.FromInt(204)
+		}), true},
+		{"string target", newSyntheticsTest(func(spec *monitoringv1alpha1.SyntheticsTestSpec) {
+			spec.Assertions[1].Target = intstr.FromString("application/json")
+		}), true},
+		{"option", newSyntheticsTest(func(spec *monitoringv1alpha1.SyntheticsTestSpec) {
+			spec.Options.FollowRedirects = &followRedirects
+		}), true},
+		{"defaulted option", newSyntheticsTest(func(spec *monitoringv1alpha1.SyntheticsTestSpec) {
+			spec.Options.MinLocationFailed = &minLocationFailed
+		}), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			changed, err := datadog.ChangeSyntheticsTest(liveSyntheticsTest(t), test.test)
+
+			assert.NilError(t, err)
+			assert.Equal(t, changed, test.expected)
+		})
+	}
+}
+
+func TestIsSyntheticsTestPaused(t *testing.T) {
+	ddTest := &datadog.SyntheticsTest{}
+	assert.Assert(t, !datadog.IsSyntheticsTestPaused(ddTest))
+
+	ddTest.SetStatus(datadog.SyntheticsTestStatusLive)
+	assert.Assert(t, !datadog.IsSyntheticsTestPaused(ddTest))
+
+	ddTest.SetStatus(datadog.SyntheticsTestStatusPaused)
+	assert.Assert(t, datadog.IsSyntheticsTestPaused(ddTest))
+}
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+
+	"github.com/go-logr/logr"
+)
+
+// Check returns an error when the operator is not able to do its work.
+type Check func() error
+
+// Server serves the liveness and readiness endpoints used by the kubelet
+// probes. It runs on every replica, not only the leader.
+type Server struct {
+	Addr        string
+	Log         logr.Logger
+	ReadyChecks map[string]Check
+}
+
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *Server) healthz(w http.ResponseWriter, r *http.Request) {
+	fmt.Fprint(w, "ok")
+}
+
+func (s *Server) readyz(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(s.ReadyChecks))
+	for name := range s.ReadyChecks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	status := http.StatusOK
+	for _, name := range names {
+		if err := s.ReadyChecks[name](); err != nil {
+			status = http.StatusServiceUnavailable
+			fmt.Fprintf(&body, "[-]%s failed: %s\n", name, err)
+		} else {
+			fmt.Fprintf(&body, "[+]%s ok\n", name)
+		}
+	}
+
+	w.WriteHeader(status)
+	body.WriteTo(w)
+}
+
+func (s *Server) Start(stop <-chan struct{}) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", s.healthz)
+	mux.HandleFunc("/readyz", s.readyz)
+
+	listener, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{Handler: mux}
+
+	go func() {
+		<-stop
+		server.Shutdown(context.Background())
+	}()
+
+	s.Log.Info("starting health server", "addr", s.Addr)
+
+	if err := server.Serve(listener); err != http.ErrServerClosed {
+		return err
+	}
+
+	return nil
+}
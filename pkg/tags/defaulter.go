@@ -0,0 +1,87 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	ClusterTag   = "kube_cluster"
+	NamespaceTag = "kube_namespace"
+)
+
+// Defaulter adds the tags every monitor in the cluster should carry.
+type Defaulter struct {
+	ClusterName string
+	// NamespaceLabels maps namespace labels to the tag keys their values are
+	// copied to.
+	NamespaceLabels map[string]string
+}
+
+// ParseNamespaceLabels reads a comma separated list of label=tag pairs, the
+// tag key defaults to the label name when only a label is given.
+func ParseNamespaceLabels(value string) (map[string]string, error) {
+	labels := map[string]string{}
+
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		parts := strings.SplitN(pair, "=", 2)
+		label, tag := parts[0], parts[0]
+		if len(parts) == 2 {
+			tag = parts[1]
+		}
+
+		if label == "" || tag == "" {
+			return nil, fmt.Errorf("invalid namespace label tag %q, expected label or label=tag", pair)
+		}
+
+		labels[label] = tag
+	}
+
+	return labels, nil
+}
+
+func key(tag string) string {
+	return strings.SplitN(tag, ":", 2)[0]
+}
+
+// Default returns tags with the default tags appended, a default whose key
+// is already set is left out so tags can be overridden per monitor.
+func (d *Defaulter) Default(tags []string, namespace *corev1.Namespace) []string {
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		keys[key(tag)] = true
+	}
+
+	out := append([]string{}, tags...)
+	add := func(key, value string) {
+		if value == "" || keys[key] {
+			return
+		}
+
+		keys[key] = true
+		out = append(out, key+":"+value)
+	}
+
+	add(ClusterTag, d.ClusterName)
+	add(NamespaceTag, namespace.Name)
+
+	labels := make([]string, 0, len(d.NamespaceLabels))
+	for label := range d.NamespaceLabels {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		add(d.NamespaceLabels[label], namespace.Labels[label])
+	}
+
+	return out
+}
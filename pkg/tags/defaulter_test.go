@@ -0,0 +1,50 @@
+package tags_test
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/stefansedich/datadog-operator/pkg/tags"
+)
+
+func TestParseNamespaceLabels(t *testing.T) {
+	labels, err := tags.ParseNamespaceLabels("team, cost-center=cost_center,")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, labels, map[string]string{"team": "team", "cost-center": "cost_center"})
+
+	_, err = tags.ParseNamespaceLabels("team=")
+	assert.ErrorContains(t, err, "invalid namespace label tag")
+}
+
+func TestDefault(t *testing.T) {
+	defaulter := &tags.Defaulter{
+		ClusterName:     "prod",
+		NamespaceLabels: map[string]string{"team": "team", "cost-center": "cost_center"},
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "payments",
+			Labels: map[string]string{"team": "payments", "cost-center": "42"},
+		},
+	}
+
+	tests := []struct {
+		tags     []string
+		expected []string
+	}{
+		{nil, []string{"kube_cluster:prod", "kube_namespace:payments", "cost_center:42", "team:payments"}},
+		{[]string{"service:api", "team:core"}, []string{"service:api", "team:core", "kube_cluster:prod", "kube_namespace:payments", "cost_center:42"}},
+		{
+			[]string{"kube_cluster:prod", "kube_namespace:payments", "cost_center:42", "team:payments"},
+			[]string{"kube_cluster:prod", "kube_namespace:payments", "cost_center:42", "team:payments"},
+		},
+	}
+
+	for _, test := range tests {
+		assert.DeepEqual(t, defaulter.Default(test.tags, namespace), test.expected)
+	}
+}
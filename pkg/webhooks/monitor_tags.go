@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/stefansedich/datadog-operator/pkg/tags"
+)
+
+const MonitorTagsPath = "/mutate-monitoring-datadog-com-monitor-tags"
+
+// +kubebuilder:webhook:verbs=create;update,path=/mutate-monitoring-datadog-com-monitor-tags,mutating=true,failurePolicy=fail,groups=monitoring.datadog.com,resources=monitors,versions=v1alpha1;v1beta1,name=mmonitor.monitoring.datadog.com
+
+// MonitorTags adds the default tags to monitors as they are admitted, spec.tags
+// is the same in every version so monitors are handled as unstructured.
+type MonitorTags struct {
+	Client client.Client
+	Tags   *tags.Defaulter
+
+	decoder *admission.Decoder
+}
+
+var _ admission.DecoderInjector = &MonitorTags{}
+
+func (m *MonitorTags) InjectDecoder(decoder *admission.Decoder) error {
+	m.decoder = decoder
+	return nil
+}
+
+func (m *MonitorTags) Handle(ctx context.Context, req admission.Request) admission.Response {
+	monitor := &unstructured.Unstructured{}
+	err := m.decoder.Decode(req, monitor)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	monitorTags, _, err := unstructured.NestedStringSlice(monitor.Object, "spec", "tags")
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	namespace := &corev1.Namespace{}
+	err = m.Client.Get(ctx, types.NamespacedName{Name: req.Namespace}, namespace)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	err = unstructured.SetNestedStringSlice(monitor.Object, m.Tags.Default(monitorTags, namespace), "spec", "tags")
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	marshaled, err := json.Marshal(monitor)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
@@ -0,0 +1,92 @@
+package webhooks_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"gotest.tools/assert"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/stefansedich/datadog-operator/pkg/tags"
+	"github.com/stefansedich/datadog-operator/pkg/webhooks"
+)
+
+func newMonitorTags(t *testing.T, objs ...runtime.Object) *webhooks.MonitorTags {
+	scheme := runtime.NewScheme()
+	assert.NilError(t, clientgoscheme.AddToScheme(scheme))
+
+	decoder, err := admission.NewDecoder(scheme)
+	assert.NilError(t, err)
+
+	handler := &webhooks.MonitorTags{
+		Client: fake.NewFakeClientWithScheme(scheme, objs...),
+		Tags:   &tags.Defaulter{ClusterName: "prod", NamespaceLabels: map[string]string{"team": "team"}},
+	}
+	assert.NilError(t, handler.InjectDecoder(decoder))
+
+	return handler
+}
+
+func monitorRequest(namespace, spec string) admission.Request {
+	raw := fmt.Sprintf(`{"apiVersion":"monitoring.datadog.com/v1beta1","kind":"Monitor","metadata":{"name":"high-cpu","namespace":%q},"spec":%s}`, namespace, spec)
+
+	return admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+		Namespace: namespace,
+		Object:    runtime.RawExtension{Raw: []byte(raw)},
+	}}
+}
+
+func patches(response admission.Response) []string {
+	out := []string{}
+	for _, patch := range response.Patches {
+		out = append(out, fmt.Sprintf("%s %s %v", patch.Operation, patch.Path, patch.Value))
+	}
+
+	return out
+}
+
+func TestMonitorTags(t *testing.T) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Labels: map[string]string{"team": "payments"}},
+	}
+
+	tests := []struct {
+		spec    string
+		patches []string
+	}{
+		{`{"name":"High CPU"}`, []string{
+			"add /spec/tags [kube_cluster:prod kube_namespace:payments team:payments]",
+		}},
+		{`{"name":"High CPU","tags":["service:api"]}`, []string{
+			"add /spec/tags/1 team:payments",
+			"add /spec/tags/1 kube_namespace:payments",
+			"add /spec/tags/1 kube_cluster:prod",
+		}},
+		{`{"name":"High CPU","tags":["kube_cluster:staging","team:core"]}`, []string{
+			"add /spec/tags/2 kube_namespace:payments",
+		}},
+		{`{"name":"High CPU","tags":["kube_cluster:staging","kube_namespace:other","team:core"]}`, []string{}},
+	}
+
+	for _, test := range tests {
+		response := newMonitorTags(t, namespace).Handle(context.Background(), monitorRequest("payments", test.spec))
+
+		assert.Assert(t, response.Allowed)
+		assert.DeepEqual(t, patches(response), test.patches)
+	}
+}
+
+func TestMonitorTagsMissingNamespace(t *testing.T) {
+	response := newMonitorTags(t).Handle(context.Background(), monitorRequest("payments", `{"name":"High CPU"}`))
+
+	assert.Assert(t, !response.Allowed)
+	assert.Equal(t, response.Result.Code, int32(http.StatusInternalServerError))
+}